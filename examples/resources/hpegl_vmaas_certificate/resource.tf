@@ -0,0 +1,41 @@
+# (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+resource "hpegl_vmaas_certificate" "tf_certificate" {
+  name        = "tf_certificate"
+  description = "Certificate uploaded via terraform"
+  certificate = file("${path.module}/certs/server.crt")
+  private_key = file("${path.module}/certs/server.key")
+  chain       = file("${path.module}/certs/chain.crt")
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+
+resource "hpegl_vmaas_load_balancer_virtual_server" "tf_lb_virtual_server" {
+  lb_id       = data.hpegl_vmaas_load_balancer.tf_lb.id
+  name        = "tf_virtual-server"
+  vip_address = "10.11.12.13"
+  vip_port    = "443"
+  pool        = data.hpegl_vmaas_load_balancer_pool.tf_pool.id
+
+  type = "http"
+  http_application_profile {
+    application_profile = data.hpegl_vmaas_load_balancer_profile.tf_http_profile.id
+  }
+
+  persistence = "COOKIE"
+  cookie_persistence_profile {
+    persistence_profile = data.hpegl_vmaas_load_balancer_profile.tf_cookie_profile.id
+  }
+
+  ssl_server_cert = hpegl_vmaas_certificate.tf_certificate.id
+  ssl_server_config {
+    ssl_server_profile = data.hpegl_vmaas_load_balancer_profile.tf_ssl_server_profile.id
+  }
+
+  ssl_client_cert = hpegl_vmaas_certificate.tf_certificate.id
+  ssl_client_config {
+    ssl_client_profile = data.hpegl_vmaas_load_balancer_profile.tf_ssl_client_profile.id
+  }
+}
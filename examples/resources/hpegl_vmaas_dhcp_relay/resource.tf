@@ -0,0 +1,6 @@
+# (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+resource "hpegl_vmaas_dhcp_relay" "tf_dhcp_relay" {
+  name             = "tf_dhcp_relay"
+  server_addresses = ["10.10.10.10", "10.10.10.11"]
+}
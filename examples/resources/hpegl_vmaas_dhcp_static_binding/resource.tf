@@ -0,0 +1,20 @@
+# (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+resource "hpegl_vmaas_dhcp_static_binding" "tf_binding" {
+  network_id      = hpegl_vmaas_network.dhcp_net.id
+  name            = "tf_binding"
+  description     = "DHCP static binding created via terraform"
+  mac_address     = "00:50:56:aa:bb:cc"
+  ip_address      = "10.100.0.5"
+  hostname        = "tf-app-01"
+  lease_time      = 86400
+  gateway_address = "10.100.0.1"
+  option121 {
+    network  = "192.168.50.0/24"
+    next_hop = "10.100.0.254"
+  }
+  other_option {
+    code   = 42
+    values = ["10.10.10.5"]
+  }
+}
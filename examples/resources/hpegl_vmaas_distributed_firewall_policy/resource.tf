@@ -0,0 +1,25 @@
+# (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+resource "hpegl_vmaas_distributed_firewall_policy" "tf_app_policy" {
+  name        = "tf_app_policy"
+  description = "Distributed firewall policy created via terraform"
+  category    = "Application"
+  priority    = 10
+  stateful    = true
+
+  # rules are evaluated in the same order as below
+  rule {
+    name         = "web-to-db"
+    action       = "ALLOW"
+    sources      = [hpegl_vmaas_security_group.tf_web.external_id]
+    destinations = [hpegl_vmaas_security_group.tf_db.external_id]
+    services     = ["/infra/services/MySQL"]
+    logging      = true
+  }
+  rule {
+    name         = "deny-to-db"
+    action       = "DROP"
+    direction    = "IN"
+    destinations = [hpegl_vmaas_security_group.tf_db.external_id]
+  }
+}
@@ -41,6 +41,10 @@ resource "hpegl_vmaas_instance" "tf_instance" {
     asset_tag        = "vm_tag"
     folder_code      = data.hpegl_vmaas_cloud_folder.compute_folder.code
     create_user      = true
+    domain_name      = "example.local"
+    user_data        = file("${path.module}/cloud-init.yaml")
+    ssh_public_keys  = [file("~/.ssh/id_rsa.pub")]
+    workflow_id      = 12
   }
   hostname = "tf_host_1"
   scale    = 2
@@ -67,4 +71,8 @@ resource "hpegl_vmaas_instance" "tf_instance" {
     name        = "test_snapshot_1"
     description = "test snapshot description is optional"
   }
+  # Revert works only on pre-created instance. Instance will be reverted to the
+  # snapshot whenever revert_snapshot or revert_trigger is changed.
+  # revert_snapshot = "test_snapshot_1"
+  # revert_trigger  = 1
 }
@@ -0,0 +1,36 @@
+# (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+# instance which boots ready for configuration management
+resource "hpegl_vmaas_instance" "cloud_init_instance" {
+  name               = "tf-web-01"
+  hostname           = "tf-web-01"
+  cloud_id           = data.hpegl_vmaas_cloud.cloud.id
+  group_id           = data.hpegl_vmaas_group.default_group.id
+  layout_id          = data.hpegl_vmaas_layout.vmware_centos.id
+  plan_id            = data.hpegl_vmaas_plan.g1_small.id
+  instance_type_code = data.hpegl_vmaas_layout.vmware_centos.instance_type_code
+  network {
+    id = data.hpegl_vmaas_network.blue_net.id
+  }
+
+  volume {
+    name         = "root_vol"
+    size         = 5
+    datastore_id = data.hpegl_vmaas_datastore.c_3par.id
+  }
+
+  config {
+    resource_pool_id = data.hpegl_vmaas_resource_pool.cl_resource_pool.id
+    template_id      = data.hpegl_vmaas_template.vanilla.id
+    folder_code      = data.hpegl_vmaas_cloud_folder.compute_folder.code
+    domain_name      = "example.local"
+    ssh_public_keys  = [file("~/.ssh/id_rsa.pub")]
+    # workflow to be executed after provisioning
+    workflow_id = 12
+
+    user_data = templatefile("${path.module}/cloud-init.yaml.tftpl", {
+      puppet_server = "puppet.example.local"
+    })
+  }
+  environment_code = data.hpegl_vmaas_environment.dev.code
+}
@@ -0,0 +1,39 @@
+# (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+# Reserve the IP address of the instance from the network pool
+resource "hpegl_vmaas_ip_address" "db_ip" {
+  pool_id  = hpegl_vmaas_network_pool.tf_pool.id
+  hostname = "tf-db-01"
+}
+
+# instance with static IP address on the primary interface
+resource "hpegl_vmaas_instance" "static_ip_instance" {
+  name               = "tf-db-01"
+  cloud_id           = data.hpegl_vmaas_cloud.cloud.id
+  group_id           = data.hpegl_vmaas_group.default_group.id
+  layout_id          = data.hpegl_vmaas_layout.vmware_centos.id
+  plan_id            = data.hpegl_vmaas_plan.g1_small.id
+  instance_type_code = data.hpegl_vmaas_layout.vmware_centos.instance_type_code
+  network {
+    id         = data.hpegl_vmaas_network.blue_net.id
+    ip_mode    = "static"
+    ip_address = hpegl_vmaas_ip_address.db_ip.ip_address
+  }
+  network {
+    id      = data.hpegl_vmaas_network.green_net.id
+    ip_mode = "pool"
+  }
+
+  volume {
+    name         = "root_vol"
+    size         = 5
+    datastore_id = data.hpegl_vmaas_datastore.c_3par.id
+  }
+
+  config {
+    resource_pool_id = data.hpegl_vmaas_resource_pool.cl_resource_pool.id
+    template_id      = data.hpegl_vmaas_template.vanilla.id
+    folder_code      = data.hpegl_vmaas_cloud_folder.compute_folder.code
+  }
+  environment_code = data.hpegl_vmaas_environment.dev.code
+}
@@ -0,0 +1,7 @@
+# (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+resource "hpegl_vmaas_instance_snapshot" "tf_snapshot" {
+  instance_id = hpegl_vmaas_instance.tf_instance.id
+  name        = "tf_snapshot"
+  description = "snapshot before patching"
+}
@@ -0,0 +1,14 @@
+# (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+# Allocate the first free IP address of the pool
+resource "hpegl_vmaas_ip_address" "tf_appliance_ip" {
+  pool_id  = hpegl_vmaas_network_pool.tf_pool.id
+  hostname = "tf-appliance-01"
+}
+
+# Reserve a specific IP address of the pool
+resource "hpegl_vmaas_ip_address" "tf_reserved_ip" {
+  pool_id    = hpegl_vmaas_network_pool.tf_pool.id
+  ip_address = "10.100.0.50"
+  hostname   = "tf-appliance-02"
+}
@@ -0,0 +1,61 @@
+# (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+resource "hpegl_vmaas_load_balancer_virtual_server" "tf_lb_virtual_server_rules" {
+  lb_id       = data.hpegl_vmaas_load_balancer.tf_lb.id
+  name        = "tf_virtual-server-rules"
+  description = "tf_virtual-server with rules created by tf"
+  vip_address = "10.11.12.14"
+  vip_port    = "80"
+  pool        = data.hpegl_vmaas_load_balancer_pool.tf_pool.id
+
+  type = "http"
+  http_application_profile {
+    application_profile = data.hpegl_vmaas_load_balancer_profile.tf_http_profile.id
+  }
+
+  persistence = "COOKIE"
+  cookie_persistence_profile {
+    persistence_profile = data.hpegl_vmaas_load_balancer_profile.tf_cookie_profile.id
+  }
+
+  # forward /app2 to a different pool
+  rule {
+    phase = "HTTP_FORWARDING"
+    condition {
+      type       = "LBHttpRequestUriCondition"
+      match_type = "STARTS_WITH"
+      uri        = "/app2"
+    }
+    action {
+      type = "LBSelectPoolAction"
+      pool = data.hpegl_vmaas_load_balancer_pool.tf_pool_app2.id
+    }
+  }
+
+  # redirect the legacy host to the new one
+  rule {
+    phase          = "HTTP_FORWARDING"
+    match_strategy = "ANY"
+    condition {
+      type         = "LBHttpRequestHeaderCondition"
+      match_type   = "EQUALS"
+      header_name  = "Host"
+      header_value = "legacy.example.com"
+    }
+    action {
+      type            = "LBHttpRedirectAction"
+      redirect_url    = "https://app.example.com"
+      redirect_status = "301"
+    }
+  }
+
+  # add a header to all requests
+  rule {
+    phase = "HTTP_REQUEST_REWRITE"
+    action {
+      type         = "LBHttpRequestHeaderRewriteAction"
+      header_name  = "X-Forwarded-Proto"
+      header_value = "https"
+    }
+  }
+}
@@ -0,0 +1,19 @@
+# (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+resource "hpegl_vmaas_network" "dhcp_relay_net" {
+  name              = "tf_nsx_t_dhcp_relay_network"
+  description       = "DHCP relay Network create using tf"
+  display_name      = "tf_nsx_t_dhcp_relay_network"
+  scope_id          = data.hpegl_vmaas_transport_zone.tf_zone.provider_id
+  cidr              = "10.110.0.1/24"
+  group_id          = "shared"
+  dhcp_enabled      = true
+  connected_gateway = data.hpegl_vmaas_router.tier1_router.provider_id
+  resource_permissions {
+    all = true
+  }
+  dhcp_network {
+    dhcp_type   = "dhcpRelay"
+    dhcp_server = hpegl_vmaas_dhcp_relay.tf_dhcp_relay.provider_id
+  }
+}
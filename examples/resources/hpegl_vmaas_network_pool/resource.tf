@@ -0,0 +1,16 @@
+# (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+resource "hpegl_vmaas_network_pool" "tf_pool" {
+  name        = "tf_pool"
+  gateway     = "10.100.0.1"
+  netmask     = "255.255.255.0"
+  dns_servers = ["10.10.10.5", "10.10.10.6"]
+  ip_range {
+    start_address = "10.100.0.10"
+    end_address   = "10.100.0.100"
+  }
+  ip_range {
+    start_address = "10.100.0.150"
+    end_address   = "10.100.0.200"
+  }
+}
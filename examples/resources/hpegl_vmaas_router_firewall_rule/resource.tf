@@ -0,0 +1,15 @@
+# (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+resource "hpegl_vmaas_router_firewall_rule" "tf_router_firewall_rule" {
+  name          = "tf_router_firewall_rule"
+  router_id     = data.hpegl_vmaas_router.tf_router.id
+  rule_group_id = hpegl_vmaas_router_firewall_rule_group.tf_router_firewall_rule_group.id
+  description   = "Router firewall rule created via terraform"
+  enabled       = true
+  priority      = 110
+  action        = "ALLOW"
+  direction     = "IN_OUT"
+  sources       = ["/infra/domains/default/groups/Application-Group"]
+  services      = ["/infra/services/HTTPS"]
+  logging       = true
+}
@@ -0,0 +1,29 @@
+# (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+resource "hpegl_vmaas_router_interface" "tf_uplink" {
+  router_id   = hpegl_vmaas_router.tf_tier0.id
+  name        = "tf_uplink"
+  description = "Uplink interface created via terraform"
+  type        = "EXTERNAL"
+  edge_node   = "/infra/sites/default/enforcement-points/default/edge-clusters/${data.hpegl_vmaas_edge_cluster.tf_edge_cluster.provider_id}/edge-nodes/0"
+  segment_id  = data.hpegl_vmaas_network.tf_uplink_segment.id
+  cidr        = "192.168.100.2/24"
+  mtu         = 1500
+  urpf_mode   = "STRICT"
+}
+
+resource "hpegl_vmaas_router_interface" "tf_service" {
+  router_id  = hpegl_vmaas_router.tf_tier0.id
+  name       = "tf_service"
+  type       = "SERVICE"
+  segment_id = data.hpegl_vmaas_network.tf_service_segment.id
+  cidr       = "192.168.110.1/24"
+}
+
+resource "hpegl_vmaas_router_interface" "tf_loopback" {
+  router_id = hpegl_vmaas_router.tf_tier0.id
+  name      = "tf_loopback"
+  type      = "LOOPBACK"
+  edge_node = "/infra/sites/default/enforcement-points/default/edge-clusters/${data.hpegl_vmaas_edge_cluster.tf_edge_cluster.provider_id}/edge-nodes/0"
+  cidr      = "10.255.255.1/32"
+}
@@ -13,6 +13,6 @@ resource "hpegl_vmaas_router_nat_rule" "tf_nat" {
   source_network      = "1.1.3.0/24"
   translated_network  = "1.1.1.0/24"
   destination_network = "1.1.2.0/24"
-  translated_ports    = 22
+  translated_ports    = "22"
   priority            = 120
 }
@@ -0,0 +1,11 @@
+# (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+resource "hpegl_vmaas_router_vpn_dpd_profile" "tf_vpn_dpd_profile" {
+  router_id          = data.hpegl_vmaas_router.tf_router.id
+  name               = "tf_vpn_dpd_profile"
+  description        = "DPD profile created via terraform"
+  enabled            = true
+  dpd_probe_mode     = "PERIODIC"
+  dpd_probe_interval = 60
+  retry_count        = 10
+}
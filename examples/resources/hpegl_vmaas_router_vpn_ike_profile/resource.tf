@@ -0,0 +1,12 @@
+# (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+resource "hpegl_vmaas_router_vpn_ike_profile" "tf_vpn_ike_profile" {
+  router_id             = data.hpegl_vmaas_router.tf_router.id
+  name                  = "tf_vpn_ike_profile"
+  description           = "IKE profile created via terraform"
+  ike_version           = "IKE_V2"
+  encryption_algorithms = ["AES_256"]
+  digest_algorithms     = ["SHA2_256"]
+  dh_groups             = ["GROUP14"]
+  sa_life_time          = 86400
+}
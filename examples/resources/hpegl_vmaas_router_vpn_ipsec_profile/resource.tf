@@ -0,0 +1,12 @@
+# (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+resource "hpegl_vmaas_router_vpn_ipsec_profile" "tf_vpn_ipsec_profile" {
+  router_id                      = data.hpegl_vmaas_router.tf_router.id
+  name                           = "tf_vpn_ipsec_profile"
+  description                    = "IPsec profile created via terraform"
+  encryption_algorithms          = ["AES_GCM_128"]
+  dh_groups                      = ["GROUP14"]
+  enable_perfect_forward_secrecy = true
+  sa_life_time                   = 3600
+  df_policy                      = "COPY"
+}
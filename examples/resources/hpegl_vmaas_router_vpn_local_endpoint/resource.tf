@@ -0,0 +1,10 @@
+# (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+resource "hpegl_vmaas_router_vpn_local_endpoint" "tf_vpn_local_endpoint" {
+  router_id      = data.hpegl_vmaas_router.tf_router.id
+  vpn_service_id = hpegl_vmaas_router_vpn_service.tf_vpn_service.id
+  name           = "tf_vpn_local_endpoint"
+  description    = "IPsec VPN local endpoint created via terraform"
+  local_address  = "192.0.2.10"
+  local_id       = "192.0.2.10"
+}
@@ -0,0 +1,10 @@
+# (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+resource "hpegl_vmaas_router_vpn_service" "tf_vpn_service" {
+  router_id     = data.hpegl_vmaas_router.tf_router.id
+  name          = "tf_vpn_service"
+  description   = "IPsec VPN service created via terraform"
+  enabled       = true
+  ike_log_level = "INFO"
+  ha_sync       = true
+}
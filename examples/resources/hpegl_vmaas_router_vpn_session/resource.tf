@@ -0,0 +1,36 @@
+# (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+# Policy based session, traffic matching the rules is protected
+resource "hpegl_vmaas_router_vpn_session" "tf_vpn_session" {
+  router_id                  = data.hpegl_vmaas_router.tf_router.id
+  vpn_service_id             = hpegl_vmaas_router_vpn_service.tf_vpn_service.id
+  local_endpoint_id          = hpegl_vmaas_router_vpn_local_endpoint.tf_vpn_local_endpoint.id
+  name                       = "tf_vpn_session"
+  description                = "Policy based IPsec VPN session created via terraform"
+  type                       = "POLICY_BASED"
+  peer_address               = "203.0.113.10"
+  peer_id                    = "203.0.113.10"
+  psk                        = var.vpn_psk
+  ike_profile_id             = hpegl_vmaas_router_vpn_ike_profile.tf_vpn_ike_profile.id
+  ipsec_profile_id           = hpegl_vmaas_router_vpn_ipsec_profile.tf_vpn_ipsec_profile.id
+  dpd_profile_id             = hpegl_vmaas_router_vpn_dpd_profile.tf_vpn_dpd_profile.id
+  connection_initiation_mode = "INITIATOR"
+  rule {
+    sources      = ["192.168.10.0/24"]
+    destinations = ["10.10.0.0/16"]
+    action       = "PROTECT"
+  }
+}
+
+# Route based session, routes towards the peer should be configured
+# over the virtual tunnel interface
+resource "hpegl_vmaas_router_vpn_session" "tf_vpn_route_session" {
+  router_id             = data.hpegl_vmaas_router.tf_router.id
+  vpn_service_id        = hpegl_vmaas_router_vpn_service.tf_vpn_service.id
+  local_endpoint_id     = hpegl_vmaas_router_vpn_local_endpoint.tf_vpn_local_endpoint.id
+  name                  = "tf_vpn_route_session"
+  type                  = "ROUTE_BASED"
+  peer_address          = "198.51.100.20"
+  psk                   = var.vpn_psk
+  tunnel_interface_cidr = "169.254.10.1/30"
+}
@@ -0,0 +1,21 @@
+# (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+# Members by the tags of hpegl_vmaas_instance. Instance tag `role = "web"`
+# is matched by scope "role" and tag "web"
+resource "hpegl_vmaas_security_group" "tf_web" {
+  name        = "tf_web"
+  description = "Web servers"
+  tag {
+    scope = "role"
+    tag   = "web"
+  }
+}
+
+# Members by the instances, the segments and the IP addresses
+resource "hpegl_vmaas_security_group" "tf_db" {
+  name         = "tf_db"
+  description  = "Database servers"
+  instance_ids = [hpegl_vmaas_instance.tf_db.id]
+  segment_ids  = [data.hpegl_vmaas_network.tf_db_segment.id]
+  ip_addresses = ["192.168.20.0/24", "192.168.30.10"]
+}
@@ -4,11 +4,18 @@ package acceptancetest
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"log"
+	"net/http"
+	"net/url"
 	"os"
 	"strconv"
+	"strings"
 
 	api_client "github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
+	consts "github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/common"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/atf"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/constants"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/utils"
 	"github.com/hewlettpackard/hpegl-provider-lib/pkg/token/retrieve"
@@ -30,44 +37,98 @@ func getAPIClient() (*api_client.APIClient, api_client.Configuration) {
 			constants.LocationKey: os.Getenv("HPEGL_VMAAS_LOCATION"),
 			constants.SpaceKey:    os.Getenv("HPEGL_VMAAS_SPACE_NAME"),
 		},
+		HTTPClient: atf.HTTPClient(),
 	}
 
 	apiClient := api_client.NewAPIClient(&cfg)
-	err := apiClient.SetMeta(nil, func(ctx *context.Context, meta interface{}) {
-		d := &utils.ResourceData{
-			Data: map[string]interface{}{
-				"iam_service_url":           os.Getenv("HPEGL_IAM_SERVICE_URL"),
-				"tenant_id":                 os.Getenv("HPEGL_TENANT_ID"),
-				"user_id":                   os.Getenv("HPEGL_USER_ID"),
-				"user_secret":               os.Getenv("HPEGL_USER_SECRET"),
-				"api_vended_service_client": true,
-				"iam_token":                 os.Getenv("HPEGL_IAM_TOKEN"),
-			},
-		}
-		if utils.GetEnvBool(constants.MockIAMKey) {
-			return
-		}
+	err := apiClient.SetMeta(nil, setAccToken)
+	if err != nil {
+		log.Printf("[WARN] Error: %s", err)
+	}
 
-		// Initialise token handler
-		h, err := serviceclient.NewHandler(d)
-		if err != nil {
-			log.Printf("[WARN] Unable to fetch token for SCM client: %s", err)
-		}
+	return apiClient, cfg
+}
 
-		// Get token retrieve func and put in c
-		trf := retrieve.NewTokenRetrieveFunc(h)
-		token, err := trf(*ctx)
-		if err != nil {
-			log.Printf("[WARN] Unable to fetch token for SCM client: %s", err)
-		} else {
-			*ctx = context.WithValue(*ctx, api_client.ContextAccessToken, token)
+// setAccToken sets the IAM token in the context, unless IAM is mocked
+func setAccToken(ctx *context.Context, meta interface{}) {
+	d := &utils.ResourceData{
+		Data: map[string]interface{}{
+			"iam_service_url":           os.Getenv("HPEGL_IAM_SERVICE_URL"),
+			"tenant_id":                 os.Getenv("HPEGL_TENANT_ID"),
+			"user_id":                   os.Getenv("HPEGL_USER_ID"),
+			"user_secret":               os.Getenv("HPEGL_USER_SECRET"),
+			"api_vended_service_client": true,
+			"iam_token":                 os.Getenv("HPEGL_IAM_TOKEN"),
+		},
+	}
+	if utils.GetEnvBool(constants.MockIAMKey) {
+		return
+	}
+
+	// Initialise token handler
+	h, err := serviceclient.NewHandler(d)
+	if err != nil {
+		log.Printf("[WARN] Unable to fetch token for SCM client: %s", err)
+	}
+
+	// Get token retrieve func and put in c
+	trf := retrieve.NewTokenRetrieveFunc(h)
+	token, err := trf(*ctx)
+	if err != nil {
+		log.Printf("[WARN] Unable to fetch token for SCM client: %s", err)
+	} else {
+		*ctx = context.WithValue(*ctx, api_client.ContextAccessToken, token)
+	}
+}
+
+// getAccAPI calls the GET API of CMP, which is not available in cmp-sdk, and
+// parses the JSON response. Error response is parsed in the same way as cmp-sdk,
+// so that the status code is available to check the destroy.
+func getAccAPI(path string) (map[string]interface{}, error) {
+	_, cfg := getAPIClient()
+	u, err := url.Parse(fmt.Sprintf("%s/%s/%s", cfg.Host, consts.VmaasCmpAPIBasePath, path))
+	if err != nil {
+		return nil, err
+	}
+	query := u.Query()
+	for k, v := range cfg.DefaultQueryParams {
+		query.Add(k, v)
+	}
+	u.RawQuery = query.Encode()
+
+	ctx := getAccContext()
+	setAccToken(&ctx, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", consts.ContentType)
+	if token, ok := ctx.Value(api_client.ContextAccessToken).(string); ok {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	for header, value := range cfg.DefaultHeader {
+		if strings.TrimSpace(value) != "" {
+			req.Header.Set(header, value)
 		}
-	})
+	}
+
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req)
 	if err != nil {
-		log.Printf("[WARN] Error: %s", err)
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return nil, api_client.ParseError(resp)
 	}
 
-	return apiClient, cfg
+	respBody := make(map[string]interface{})
+	err = json.NewDecoder(resp.Body).Decode(&respBody)
+
+	return respBody, err
 }
 
 func toInt(s string) int {
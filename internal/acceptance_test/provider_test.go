@@ -4,8 +4,10 @@ package acceptancetest
 
 import (
 	"os"
+	"strings"
 	"testing"
 
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/cmpmock"
 	testutils "github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/test-utils"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/constants"
 	pkgutils "github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/utils"
@@ -24,6 +26,45 @@ func init() {
 	}
 }
 
+// mockEnvs are set while running acceptance test against mock CMP server or on
+// replay mode, if not set already. IAM is mocked since credentials are not
+// required in either case.
+var mockEnvs = map[string]string{
+	constants.MockIAMKey:     "true",
+	constants.CmpSubjectKey:  "tf-acc",
+	"HPEGL_IAM_TOKEN":        "tf-acc",
+	"HPEGL_VMAAS_LOCATION":   "mock",
+	"HPEGL_VMAAS_SPACE_NAME": "mock",
+}
+
+func TestMain(m *testing.M) {
+	switch {
+	case pkgutils.GetEnvBool(constants.MockCMPKey):
+		// Run acceptance test against in-process mock CMP server. Test cases from
+		// acc-dev-testcases are used since fixtures of mock server follows the same.
+		server := cmpmock.NewServer()
+		os.Setenv("HPEGL_VMAAS_API_URL", server.URL())
+		setEnvs(mockEnvs)
+		setEnvs(map[string]string{constants.AccTestPathKey: "../../acc-dev-testcases"})
+		code := m.Run()
+		server.Close()
+		os.Exit(code)
+	case strings.EqualFold(os.Getenv(constants.AccRecordModeKey), "replay"):
+		// API calls are served from cassettes, so any API URL works
+		setEnvs(mockEnvs)
+		setEnvs(map[string]string{"HPEGL_VMAAS_API_URL": constants.ServiceURL})
+	}
+	os.Exit(m.Run())
+}
+
+func setEnvs(envs map[string]string) {
+	for key, value := range envs {
+		if os.Getenv(key) == "" {
+			os.Setenv(key, value)
+		}
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
 	// validate all required envs are present, if not then throws error
 	var requiredenvs []string
@@ -0,0 +1,50 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package acceptancetest
+
+import (
+	"net/http"
+	"testing"
+
+	api_client "github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/atf"
+)
+
+func TestVmaasCertificatePlan(t *testing.T) {
+	acc := &atf.Acc{
+		PreCheck:     testAccPreCheck,
+		Providers:    testAccProviders,
+		ResourceName: "hpegl_vmaas_certificate",
+	}
+	acc.RunResourcePlanTest(t)
+}
+
+func TestAccResourceCertificateCreate(t *testing.T) {
+	acc := &atf.Acc{
+		ResourceName: "hpegl_vmaas_certificate",
+		PreCheck:     testAccPreCheck,
+		Providers:    testAccProviders,
+		GetAPI: func(attr map[string]string) (interface{}, error) {
+			cl, cfg := getAPIClient()
+			iClient := api_client.LoadBalancerAPIService{
+				Client: cl,
+				Cfg:    cfg,
+			}
+			id := toInt(attr["id"])
+
+			resp, err := iClient.GetLBVirtualServerSSLCerts(getAccContext())
+			if err != nil {
+				return nil, err
+			}
+			for _, cert := range resp.Certificates {
+				if cert.ID == id {
+					return cert, nil
+				}
+			}
+
+			return nil, api_client.CustomError{StatusCode: http.StatusNotFound}
+		},
+	}
+
+	acc.RunResourceTests(t)
+}
@@ -0,0 +1,56 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package acceptancetest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/atf"
+)
+
+func TestVmaasDhcpStaticBindingPlan(t *testing.T) {
+	acc := &atf.Acc{
+		PreCheck:     testAccPreCheck,
+		Providers:    testAccProviders,
+		ResourceName: "hpegl_vmaas_dhcp_static_binding",
+	}
+	acc.RunResourcePlanTest(t)
+}
+
+func TestAccResourceDhcpStaticBindingCreate(t *testing.T) {
+	acc := &atf.Acc{
+		ResourceName: "hpegl_vmaas_dhcp_static_binding",
+		PreCheck:     testAccPreCheck,
+		Providers:    testAccProviders,
+		GetAPI: func(attr map[string]string) (interface{}, error) {
+			return getAccAPI(fmt.Sprintf("networks/%s/dhcp-static-bindings/%s",
+				attr["network_id"], attr["id"]))
+		},
+	}
+
+	acc.RunResourceTests(t)
+}
+
+func TestVmaasDhcpRelayPlan(t *testing.T) {
+	acc := &atf.Acc{
+		PreCheck:     testAccPreCheck,
+		Providers:    testAccProviders,
+		ResourceName: "hpegl_vmaas_dhcp_relay",
+	}
+	acc.RunResourcePlanTest(t)
+}
+
+func TestAccResourceDhcpRelayCreate(t *testing.T) {
+	acc := &atf.Acc{
+		ResourceName: "hpegl_vmaas_dhcp_relay",
+		PreCheck:     testAccPreCheck,
+		Providers:    testAccProviders,
+		GetAPI: func(attr map[string]string) (interface{}, error) {
+			return getAccAPI(fmt.Sprintf("networks/servers/%s/dhcp-relays/%s",
+				attr["network_server_id"], attr["id"]))
+		},
+	}
+
+	acc.RunResourceTests(t)
+}
@@ -0,0 +1,56 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package acceptancetest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/atf"
+)
+
+func TestVmaasSecurityGroupPlan(t *testing.T) {
+	acc := &atf.Acc{
+		PreCheck:     testAccPreCheck,
+		Providers:    testAccProviders,
+		ResourceName: "hpegl_vmaas_security_group",
+	}
+	acc.RunResourcePlanTest(t)
+}
+
+func TestAccResourceSecurityGroupCreate(t *testing.T) {
+	acc := &atf.Acc{
+		ResourceName: "hpegl_vmaas_security_group",
+		PreCheck:     testAccPreCheck,
+		Providers:    testAccProviders,
+		GetAPI: func(attr map[string]string) (interface{}, error) {
+			return getAccAPI(fmt.Sprintf("networks/servers/%s/groups/%s",
+				attr["network_server_id"], attr["id"]))
+		},
+	}
+
+	acc.RunResourceTests(t)
+}
+
+func TestVmaasDistributedFirewallPolicyPlan(t *testing.T) {
+	acc := &atf.Acc{
+		PreCheck:     testAccPreCheck,
+		Providers:    testAccProviders,
+		ResourceName: "hpegl_vmaas_distributed_firewall_policy",
+	}
+	acc.RunResourcePlanTest(t)
+}
+
+func TestAccResourceDistributedFirewallPolicyCreate(t *testing.T) {
+	acc := &atf.Acc{
+		ResourceName: "hpegl_vmaas_distributed_firewall_policy",
+		PreCheck:     testAccPreCheck,
+		Providers:    testAccProviders,
+		GetAPI: func(attr map[string]string) (interface{}, error) {
+			return getAccAPI(fmt.Sprintf("networks/servers/%s/firewall-rule-groups/%s",
+				attr["network_server_id"], attr["id"]))
+		},
+	}
+
+	acc.RunResourceTests(t)
+}
@@ -0,0 +1,52 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package acceptancetest
+
+import (
+	"net/http"
+	"testing"
+
+	api_client "github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/atf"
+)
+
+func TestVmaasInstanceSnapshotPlan(t *testing.T) {
+	acc := &atf.Acc{
+		PreCheck:     testAccPreCheck,
+		Providers:    testAccProviders,
+		ResourceName: "hpegl_vmaas_instance_snapshot",
+	}
+	acc.RunResourcePlanTest(t)
+}
+
+func TestAccResourceInstanceSnapshotCreate(t *testing.T) {
+	acc := &atf.Acc{
+		ResourceName: "hpegl_vmaas_instance_snapshot",
+		PreCheck:     testAccPreCheck,
+		Providers:    testAccProviders,
+		GetAPI: func(attr map[string]string) (interface{}, error) {
+			cl, cfg := getAPIClient()
+			iClient := api_client.InstancesAPIService{
+				Client: cl,
+				Cfg:    cfg,
+			}
+			id := toInt(attr["id"])
+			instanceID := toInt(attr["instance_id"])
+
+			resp, err := iClient.GetListOfSnapshotsForAnInstance(getAccContext(), instanceID)
+			if err != nil {
+				return nil, err
+			}
+			for _, snapshot := range resp.Snapshots {
+				if snapshot.ID == id {
+					return snapshot, nil
+				}
+			}
+
+			// snapshot API does not return 404 for a deleted snapshot
+			return nil, api_client.CustomError{StatusCode: http.StatusNotFound}
+		},
+	}
+
+	acc.RunResourceTests(t)
+}
@@ -58,3 +58,45 @@ func TestAccResourceInstanceCreate_templateErr(t *testing.T) {
 
 	acc.RunResourceTests(t)
 }
+
+func TestAccResourceInstanceCreate_staticIP(t *testing.T) {
+	acc := &atf.Acc{
+		ResourceName: "hpegl_vmaas_instance",
+		PreCheck:     testAccPreCheck,
+		Providers:    testAccProviders,
+		Version:      "static_ip",
+		GetAPI: func(attr map[string]string) (interface{}, error) {
+			cl, cfg := getAPIClient()
+			iClient := api_client.InstancesAPIService{
+				Client: cl,
+				Cfg:    cfg,
+			}
+			id := toInt(attr["id"])
+
+			return iClient.GetASpecificInstance(getAccContext(), id)
+		},
+	}
+
+	acc.RunResourceTests(t)
+}
+
+func TestAccResourceInstanceCreate_guestCustomization(t *testing.T) {
+	acc := &atf.Acc{
+		ResourceName: "hpegl_vmaas_instance",
+		PreCheck:     testAccPreCheck,
+		Providers:    testAccProviders,
+		Version:      "guest_customization",
+		GetAPI: func(attr map[string]string) (interface{}, error) {
+			cl, cfg := getAPIClient()
+			iClient := api_client.InstancesAPIService{
+				Client: cl,
+				Cfg:    cfg,
+			}
+			id := toInt(attr["id"])
+
+			return iClient.GetASpecificInstance(getAccContext(), id)
+		},
+	}
+
+	acc.RunResourceTests(t)
+}
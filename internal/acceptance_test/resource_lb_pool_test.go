@@ -61,3 +61,25 @@ func TestAccResourceLBPoolCreate_poolErr(t *testing.T) {
 
 	acc.RunResourceTests(t)
 }
+
+func TestAccResourceLBPoolCreate_staticMembers(t *testing.T) {
+	acc := &atf.Acc{
+		ResourceName: "hpegl_vmaas_load_balancer_pool",
+		PreCheck:     testAccPreCheck,
+		Providers:    testAccProviders,
+		Version:      "static_members",
+		GetAPI: func(attr map[string]string) (interface{}, error) {
+			cl, cfg := getAPIClient()
+			iClient := api_client.LoadBalancerAPIService{
+				Client: cl,
+				Cfg:    cfg,
+			}
+			id := toInt(attr["id"])
+			lbID := toInt(attr["lb_id"])
+
+			return iClient.GetSpecificLBPool(context.Background(), lbID, id)
+		},
+	}
+
+	acc.RunResourceTests(t)
+}
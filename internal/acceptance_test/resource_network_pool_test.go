@@ -0,0 +1,48 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package acceptancetest
+
+import (
+	"testing"
+
+	api_client "github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/atf"
+)
+
+func TestVmaasNetworkPoolPlan(t *testing.T) {
+	acc := &atf.Acc{
+		PreCheck:     testAccPreCheck,
+		Providers:    testAccProviders,
+		ResourceName: "hpegl_vmaas_network_pool",
+	}
+	acc.RunResourcePlanTest(t)
+}
+
+func TestAccResourceNetworkPoolCreate(t *testing.T) {
+	acc := &atf.Acc{
+		ResourceName: "hpegl_vmaas_network_pool",
+		PreCheck:     testAccPreCheck,
+		Providers:    testAccProviders,
+		GetAPI: func(attr map[string]string) (interface{}, error) {
+			cl, cfg := getAPIClient()
+			iClient := api_client.NetworksAPIService{
+				Client: cl,
+				Cfg:    cfg,
+			}
+			id := toInt(attr["id"])
+
+			return iClient.GetSpecificNetworkPool(getAccContext(), id)
+		},
+	}
+
+	acc.RunResourceTests(t)
+}
+
+func TestVmaasIPAddressPlan(t *testing.T) {
+	acc := &atf.Acc{
+		PreCheck:     testAccPreCheck,
+		Providers:    testAccProviders,
+		ResourceName: "hpegl_vmaas_ip_address",
+	}
+	acc.RunResourcePlanTest(t)
+}
@@ -24,6 +24,9 @@ func TestAccResourceNetworkCreate(t *testing.T) {
 		ResourceName: "hpegl_vmaas_network",
 		PreCheck:     testAccPreCheck,
 		Providers:    testAccProviders,
+		Import:       true,
+		// resource permissions are not returned with the network
+		ImportStateVerifyIgnore: []string{"resource_permissions"},
 		GetAPI: func(attr map[string]string) (interface{}, error) {
 			cl, cfg := getAPIClient()
 			iClient := api_client.NetworksAPIService{
@@ -0,0 +1,40 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package acceptancetest
+
+import (
+	"testing"
+
+	api_client "github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/atf"
+)
+
+func TestVmaasRouterFirewallRuleGroupPlan(t *testing.T) {
+	acc := &atf.Acc{
+		PreCheck:     testAccPreCheck,
+		Providers:    testAccProviders,
+		ResourceName: "hpegl_vmaas_router_firewall_rule_group",
+	}
+	acc.RunResourcePlanTest(t)
+}
+
+func TestAccResourceRouterFirewallRuleGroupCreate(t *testing.T) {
+	acc := &atf.Acc{
+		ResourceName: "hpegl_vmaas_router_firewall_rule_group",
+		PreCheck:     testAccPreCheck,
+		Providers:    testAccProviders,
+		GetAPI: func(attr map[string]string) (interface{}, error) {
+			cl, cfg := getAPIClient()
+			iClient := api_client.RouterAPIService{
+				Client: cl,
+				Cfg:    cfg,
+			}
+			id := toInt(attr["id"])
+			routerID := toInt(attr["router_id"])
+
+			return iClient.GetSpecificRouterFirewallRuleGroup(getAccContext(), routerID, id)
+		},
+	}
+
+	acc.RunResourceTests(t)
+}
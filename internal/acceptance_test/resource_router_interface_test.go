@@ -0,0 +1,51 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package acceptancetest
+
+import (
+	"net/http"
+	"testing"
+
+	api_client "github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/atf"
+)
+
+func TestVmaasRouterInterfacePlan(t *testing.T) {
+	acc := &atf.Acc{
+		PreCheck:     testAccPreCheck,
+		Providers:    testAccProviders,
+		ResourceName: "hpegl_vmaas_router_interface",
+	}
+	acc.RunResourcePlanTest(t)
+}
+
+func TestAccResourceRouterInterfaceCreate(t *testing.T) {
+	acc := &atf.Acc{
+		ResourceName: "hpegl_vmaas_router_interface",
+		PreCheck:     testAccPreCheck,
+		Providers:    testAccProviders,
+		GetAPI: func(attr map[string]string) (interface{}, error) {
+			cl, cfg := getAPIClient()
+			iClient := api_client.RouterAPIService{
+				Client: cl,
+				Cfg:    cfg,
+			}
+			id := toInt(attr["id"])
+			routerID := toInt(attr["router_id"])
+
+			resp, err := iClient.GetSpecificRouter(getAccContext(), routerID)
+			if err != nil {
+				return nil, err
+			}
+			for _, routerInterface := range resp.NetworkRouter.Interfaces {
+				if routerInterface.ID == id {
+					return routerInterface, nil
+				}
+			}
+
+			return nil, api_client.CustomError{StatusCode: http.StatusNotFound}
+		},
+	}
+
+	acc.RunResourceTests(t)
+}
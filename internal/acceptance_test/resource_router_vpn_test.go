@@ -0,0 +1,63 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package acceptancetest
+
+import (
+	"testing"
+
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/atf"
+)
+
+func TestVmaasRouterVpnServicePlan(t *testing.T) {
+	acc := &atf.Acc{
+		PreCheck:     testAccPreCheck,
+		Providers:    testAccProviders,
+		ResourceName: "hpegl_vmaas_router_vpn_service",
+	}
+	acc.RunResourcePlanTest(t)
+}
+
+func TestVmaasRouterVpnLocalEndpointPlan(t *testing.T) {
+	acc := &atf.Acc{
+		PreCheck:     testAccPreCheck,
+		Providers:    testAccProviders,
+		ResourceName: "hpegl_vmaas_router_vpn_local_endpoint",
+	}
+	acc.RunResourcePlanTest(t)
+}
+
+func TestVmaasRouterVpnIkeProfilePlan(t *testing.T) {
+	acc := &atf.Acc{
+		PreCheck:     testAccPreCheck,
+		Providers:    testAccProviders,
+		ResourceName: "hpegl_vmaas_router_vpn_ike_profile",
+	}
+	acc.RunResourcePlanTest(t)
+}
+
+func TestVmaasRouterVpnIpsecProfilePlan(t *testing.T) {
+	acc := &atf.Acc{
+		PreCheck:     testAccPreCheck,
+		Providers:    testAccProviders,
+		ResourceName: "hpegl_vmaas_router_vpn_ipsec_profile",
+	}
+	acc.RunResourcePlanTest(t)
+}
+
+func TestVmaasRouterVpnDpdProfilePlan(t *testing.T) {
+	acc := &atf.Acc{
+		PreCheck:     testAccPreCheck,
+		Providers:    testAccProviders,
+		ResourceName: "hpegl_vmaas_router_vpn_dpd_profile",
+	}
+	acc.RunResourcePlanTest(t)
+}
+
+func TestVmaasRouterVpnSessionPlan(t *testing.T) {
+	acc := &atf.Acc{
+		PreCheck:     testAccPreCheck,
+		Providers:    testAccProviders,
+		ResourceName: "hpegl_vmaas_router_vpn_session",
+	}
+	acc.RunResourcePlanTest(t)
+}
@@ -39,3 +39,25 @@ func TestAccResourceLBVirtualServerCreate(t *testing.T) {
 
 	acc.RunResourceTests(t)
 }
+
+func TestAccResourceLBVirtualServerCreate_rules(t *testing.T) {
+	acc := &atf.Acc{
+		ResourceName: "hpegl_vmaas_load_balancer_virtual_server",
+		PreCheck:     testAccPreCheck,
+		Providers:    testAccProviders,
+		Version:      "rules",
+		GetAPI: func(attr map[string]string) (interface{}, error) {
+			cl, cfg := getAPIClient()
+			iClient := api_client.LoadBalancerAPIService{
+				Client: cl,
+				Cfg:    cfg,
+			}
+			id := toInt(attr["id"])
+			lbID := toInt(attr["lb_id"])
+
+			return iClient.GetSpecificLBVirtualServer(context.Background(), lbID, id)
+		},
+	}
+
+	acc.RunResourceTests(t)
+}
@@ -0,0 +1,119 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package cmp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	apiClient "github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
+	consts "github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/common"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/auth"
+)
+
+// apiService implements the CMP APIs which are not available in cmp-sdk.
+// Requests are prepared in the same way as cmp-sdk, so that the host,
+// default headers, query params and token are consistent across both.
+type apiService struct {
+	cfg  apiClient.Configuration
+	meta interface{}
+}
+
+func newAPIService(cfg apiClient.Configuration) *apiService {
+	return &apiService{
+		cfg: cfg,
+	}
+}
+
+// setMeta sets the terraform meta, which is required to fetch the token
+func (a *apiService) setMeta(meta interface{}) {
+	a.meta = meta
+}
+
+// do calls the API with the request as JSON body and parses the JSON response
+// to response, if response is not nil. Error response will be parsed in the same
+// way as cmp-sdk, so that the status code can be retrieved with GetStatusCode
+func (a *apiService) do(
+	ctx context.Context,
+	method, path string,
+	request interface{},
+	queryParams map[string]string,
+	response interface{},
+) error {
+	u, err := url.Parse(fmt.Sprintf("%s/%s/%s", a.cfg.Host, consts.VmaasCmpAPIBasePath, path))
+	if err != nil {
+		return err
+	}
+	query := u.Query()
+	for k, v := range queryParams {
+		query.Add(k, v)
+	}
+	for k, v := range a.cfg.DefaultQueryParams {
+		query.Add(k, v)
+	}
+	u.RawQuery = query.Encode()
+
+	var body io.Reader
+	if request != nil {
+		reqBytes, err := json.Marshal(request)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(reqBytes)
+	}
+
+	// Set auth token in the context
+	if a.meta != nil {
+		auth.SetScmClientToken(&ctx, a.meta)
+	}
+	req, err := http.NewRequestWithContext(ctx, strings.ToUpper(method), u.String(), body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", consts.ContentType)
+	if request != nil {
+		req.Header.Set("Content-Type", consts.ContentType)
+	}
+	if a.cfg.UserAgent != "" {
+		req.Header.Set("User-Agent", a.cfg.UserAgent)
+	}
+	if token, ok := ctx.Value(apiClient.ContextAccessToken).(string); ok {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	for header, value := range a.cfg.DefaultHeader {
+		if strings.TrimSpace(value) != "" {
+			req.Header.Set(header, value)
+		}
+	}
+
+	httpClient := a.cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return utils.WithRetryAfter(apiClient.ParseError(resp), resp.Header.Get("Retry-After"))
+	}
+
+	respBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if response == nil || len(respBytes) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(respBytes, response)
+}
@@ -0,0 +1,76 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package cmp
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	consts "github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/common"
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
+)
+
+const certTypeCertificate = "certificate"
+
+type certificateRequest struct {
+	Certificate certificateBody `json:"certificate"`
+}
+
+type certificateResponse struct {
+	Certificate certificateBody `json:"certificate"`
+}
+
+// certificateBody is the SSL certificate. Certificate and private key are not
+// returned in the response
+type certificateBody struct {
+	ID          int    `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	CertType    string `json:"certType"`
+	CertFile    string `json:"certFile,omitempty"`
+	KeyFile     string `json:"keyFile,omitempty"`
+}
+
+func certificatePath(certID int) string {
+	return fmt.Sprintf("%s/%d", consts.LBSSLCertificatesPath, certID)
+}
+
+// CreateCertificate uploads a SSL certificate
+func (a *apiService) CreateCertificate(
+	ctx context.Context,
+	req certificateRequest,
+) (models.SuccessOrErrorMessage, error) {
+	resp := models.SuccessOrErrorMessage{}
+	err := a.do(ctx, http.MethodPost, consts.LBSSLCertificatesPath, req, nil, &resp)
+
+	return resp, err
+}
+
+// GetCertificate returns a SSL certificate
+func (a *apiService) GetCertificate(ctx context.Context, certID int) (certificateResponse, error) {
+	resp := certificateResponse{}
+	err := a.do(ctx, http.MethodGet, certificatePath(certID), nil, nil, &resp)
+
+	return resp, err
+}
+
+// UpdateCertificate updates a SSL certificate
+func (a *apiService) UpdateCertificate(
+	ctx context.Context,
+	certID int,
+	req certificateRequest,
+) (models.SuccessOrErrorMessage, error) {
+	resp := models.SuccessOrErrorMessage{}
+	err := a.do(ctx, http.MethodPut, certificatePath(certID), req, nil, &resp)
+
+	return resp, err
+}
+
+// DeleteCertificate deletes a SSL certificate
+func (a *apiService) DeleteCertificate(ctx context.Context, certID int) (models.SuccessOrErrorMessage, error) {
+	resp := models.SuccessOrErrorMessage{}
+	err := a.do(ctx, http.MethodDelete, certificatePath(certID), nil, nil, &resp)
+
+	return resp, err
+}
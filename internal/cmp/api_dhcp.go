@@ -0,0 +1,172 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package cmp
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	consts "github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/common"
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
+)
+
+const (
+	dhcpStaticBindingsPath = "dhcp-static-bindings"
+	dhcpRelaysPath         = "dhcp-relays"
+)
+
+type dhcpStaticBindingRequest struct {
+	StaticBinding dhcpStaticBindingBody `json:"networkDhcpStaticBinding"`
+}
+
+type dhcpStaticBindingResponse struct {
+	StaticBinding dhcpStaticBindingBody `json:"networkDhcpStaticBinding"`
+}
+
+// dhcpStaticBindingBody is the MAC to IP binding of the DHCP server of a segment
+type dhcpStaticBindingBody struct {
+	ID          int                     `json:"id,omitempty"`
+	Name        string                  `json:"name"`
+	Description string                  `json:"description"`
+	MacAddress  string                  `json:"macAddress"`
+	IPAddress   string                  `json:"ipAddress"`
+	ExternalID  string                  `json:"externalId,omitempty"`
+	Config      dhcpStaticBindingConfig `json:"config"`
+}
+
+type dhcpStaticBindingConfig struct {
+	HostName       string               `json:"hostName"`
+	LeaseTime      int                  `json:"leaseTime"`
+	GatewayAddress string               `json:"gatewayAddress"`
+	Option121      []dhcpClasslessRoute `json:"option121"`
+	OtherOptions   []dhcpGenericOption  `json:"otherOptions"`
+}
+
+// dhcpClasslessRoute is the classless static route of DHCP option 121
+type dhcpClasslessRoute struct {
+	Network string `json:"network" tf:"network"`
+	NextHop string `json:"nextHop" tf:"next_hop"`
+}
+
+// dhcpGenericOption is a DHCP option other than option 121
+type dhcpGenericOption struct {
+	Code   int      `json:"code" tf:"code"`
+	Values []string `json:"values" tf:"values"`
+}
+
+type dhcpRelayRequest struct {
+	Relay dhcpRelayBody `json:"networkDhcpRelay"`
+}
+
+type dhcpRelayResponse struct {
+	Relay dhcpRelayBody `json:"networkDhcpRelay"`
+}
+
+// dhcpRelayBody is the DHCP relay of the network server, which forwards the
+// DHCP requests of the segments to the external DHCP servers
+type dhcpRelayBody struct {
+	ID              int      `json:"id,omitempty"`
+	Name            string   `json:"name"`
+	ServerAddresses []string `json:"serverAddresses"`
+	ExternalID      string   `json:"externalId,omitempty"`
+}
+
+func dhcpStaticBindingPath(networkID int) string {
+	return fmt.Sprintf("%s/%d/%s", consts.NetworksPath, networkID, dhcpStaticBindingsPath)
+}
+
+// CreateDhcpStaticBinding creates a DHCP static binding on the segment
+func (a *apiService) CreateDhcpStaticBinding(
+	ctx context.Context,
+	networkID int,
+	req dhcpStaticBindingRequest,
+) (models.SuccessOrErrorMessage, error) {
+	resp := models.SuccessOrErrorMessage{}
+	err := a.do(ctx, http.MethodPost, dhcpStaticBindingPath(networkID), req, nil, &resp)
+
+	return resp, err
+}
+
+// GetDhcpStaticBinding returns a DHCP static binding of the segment
+func (a *apiService) GetDhcpStaticBinding(
+	ctx context.Context,
+	networkID, bindingID int,
+) (dhcpStaticBindingResponse, error) {
+	resp := dhcpStaticBindingResponse{}
+	err := a.do(ctx, http.MethodGet,
+		fmt.Sprintf("%s/%d", dhcpStaticBindingPath(networkID), bindingID), nil, nil, &resp)
+
+	return resp, err
+}
+
+// UpdateDhcpStaticBinding updates a DHCP static binding of the segment
+func (a *apiService) UpdateDhcpStaticBinding(
+	ctx context.Context,
+	networkID, bindingID int,
+	req dhcpStaticBindingRequest,
+) (models.SuccessOrErrorMessage, error) {
+	resp := models.SuccessOrErrorMessage{}
+	err := a.do(ctx, http.MethodPut,
+		fmt.Sprintf("%s/%d", dhcpStaticBindingPath(networkID), bindingID), req, nil, &resp)
+
+	return resp, err
+}
+
+// DeleteDhcpStaticBinding deletes a DHCP static binding of the segment
+func (a *apiService) DeleteDhcpStaticBinding(
+	ctx context.Context,
+	networkID, bindingID int,
+) (models.SuccessOrErrorMessage, error) {
+	resp := models.SuccessOrErrorMessage{}
+	err := a.do(ctx, http.MethodDelete,
+		fmt.Sprintf("%s/%d", dhcpStaticBindingPath(networkID), bindingID), nil, nil, &resp)
+
+	return resp, err
+}
+
+// CreateDhcpRelay creates a DHCP relay on the network server
+func (a *apiService) CreateDhcpRelay(
+	ctx context.Context,
+	serverID int,
+	req dhcpRelayRequest,
+) (models.SuccessOrErrorMessage, error) {
+	resp := models.SuccessOrErrorMessage{}
+	err := a.do(ctx, http.MethodPost, networkServerPath(serverID, dhcpRelaysPath), req, nil, &resp)
+
+	return resp, err
+}
+
+// GetDhcpRelay returns a DHCP relay of the network server
+func (a *apiService) GetDhcpRelay(ctx context.Context, serverID, relayID int) (dhcpRelayResponse, error) {
+	resp := dhcpRelayResponse{}
+	err := a.do(ctx, http.MethodGet,
+		fmt.Sprintf("%s/%d", networkServerPath(serverID, dhcpRelaysPath), relayID), nil, nil, &resp)
+
+	return resp, err
+}
+
+// UpdateDhcpRelay updates a DHCP relay of the network server
+func (a *apiService) UpdateDhcpRelay(
+	ctx context.Context,
+	serverID, relayID int,
+	req dhcpRelayRequest,
+) (models.SuccessOrErrorMessage, error) {
+	resp := models.SuccessOrErrorMessage{}
+	err := a.do(ctx, http.MethodPut,
+		fmt.Sprintf("%s/%d", networkServerPath(serverID, dhcpRelaysPath), relayID), req, nil, &resp)
+
+	return resp, err
+}
+
+// DeleteDhcpRelay deletes a DHCP relay of the network server
+func (a *apiService) DeleteDhcpRelay(
+	ctx context.Context,
+	serverID, relayID int,
+) (models.SuccessOrErrorMessage, error) {
+	resp := models.SuccessOrErrorMessage{}
+	err := a.do(ctx, http.MethodDelete,
+		fmt.Sprintf("%s/%d", networkServerPath(serverID, dhcpRelaysPath), relayID), nil, nil, &resp)
+
+	return resp, err
+}
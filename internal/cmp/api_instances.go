@@ -0,0 +1,107 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package cmp
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
+)
+
+const (
+	instancesPath = "instances"
+	snapshotsPath = "snapshots"
+	clonePath     = "clone"
+	resizePath    = "resize"
+)
+
+// instanceNetworkInterface extends the network interface of cmp-sdk with the
+// addressing of the interface
+type instanceNetworkInterface struct {
+	models.CreateInstanceBodyNetworkInterfaces
+	IPMode    string `json:"ipMode,omitempty"`
+	IPAddress string `json:"ipAddress,omitempty"`
+}
+
+// instanceConfig extends the instance config of cmp-sdk with the guest
+// customization and the workflow to be executed after provisioning
+type instanceConfig struct {
+	models.CreateInstanceBodyConfig
+	UserData      string   `json:"userData,omitempty"`
+	SSHPublicKeys []string `json:"sshPublicKeys,omitempty"`
+	TaskSetID     int      `json:"taskSetId,omitempty"`
+}
+
+// createInstanceRequest overrides the network interfaces and config of the cmp-sdk request
+type createInstanceRequest struct {
+	*models.CreateInstanceBody
+	NetworkInterfaces []instanceNetworkInterface `json:"networkInterfaces"`
+	Config            *instanceConfig            `json:"config"`
+}
+
+// cloneInstanceRequest overrides the network interfaces and config of the cmp-sdk request
+type cloneInstanceRequest struct {
+	models.CreateInstanceCloneBody
+	NetworkInterfaces []instanceNetworkInterface `json:"networkInterfaces,omitempty"`
+	Config            instanceConfig             `json:"config,omitempty"`
+}
+
+// resizeInstanceRequest overrides the network interfaces of the cmp-sdk request
+type resizeInstanceRequest struct {
+	*models.ResizeInstanceBody
+	NetworkInterfaces []instanceNetworkInterface `json:"networkInterfaces,omitempty"`
+}
+
+// CreateInstance provisions an instance
+func (a *apiService) CreateInstance(
+	ctx context.Context,
+	req createInstanceRequest,
+) (models.GetInstanceResponse, error) {
+	resp := models.GetInstanceResponse{}
+	err := a.do(ctx, http.MethodPost, instancesPath, req, nil, &resp)
+
+	return resp, err
+}
+
+// CloneInstance clones the source instance
+func (a *apiService) CloneInstance(
+	ctx context.Context,
+	sourceID int,
+	req cloneInstanceRequest,
+) (models.SuccessOrErrorMessage, error) {
+	resp := models.SuccessOrErrorMessage{}
+	err := a.do(ctx, http.MethodPut, fmt.Sprintf("%s/%d/%s", instancesPath, sourceID, clonePath), req, nil, &resp)
+
+	return resp, err
+}
+
+// ResizeInstance updates the plan, volumes and network interfaces of an instance
+func (a *apiService) ResizeInstance(
+	ctx context.Context,
+	instanceID int,
+	req resizeInstanceRequest,
+) (models.ResizeInstanceResponse, error) {
+	resp := models.ResizeInstanceResponse{}
+	err := a.do(ctx, http.MethodPut, fmt.Sprintf("%s/%d/%s", instancesPath, instanceID, resizePath), req, nil, &resp)
+
+	return resp, err
+}
+
+// DeleteSnapshot deletes a snapshot of an instance
+func (a *apiService) DeleteSnapshot(ctx context.Context, snapshotID int) (models.SuccessOrErrorMessage, error) {
+	resp := models.SuccessOrErrorMessage{}
+	err := a.do(ctx, http.MethodDelete, fmt.Sprintf("%s/%d", snapshotsPath, snapshotID), nil, nil, &resp)
+
+	return resp, err
+}
+
+// RevertSnapshot reverts an instance to the snapshot
+func (a *apiService) RevertSnapshot(ctx context.Context, instanceID, snapshotID int) (models.SuccessOrErrorMessage, error) {
+	resp := models.SuccessOrErrorMessage{}
+	err := a.do(ctx, http.MethodPut, fmt.Sprintf("%s/%d/revert-snapshot/%d", instancesPath, instanceID, snapshotID),
+		nil, nil, &resp)
+
+	return resp, err
+}
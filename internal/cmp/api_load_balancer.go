@@ -0,0 +1,178 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package cmp
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	consts "github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/common"
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
+)
+
+type lbPoolRequest struct {
+	LoadBalancerPool lbPoolBody `json:"loadBalancerPool"`
+}
+
+// lbPoolBody is the load balancer pool along with the static members, which
+// are not available in cmp-sdk model
+type lbPoolBody struct {
+	models.CreateLBPoolReq
+	Config *lbPoolConfig `json:"config"`
+}
+
+type lbPoolConfig struct {
+	*models.PoolConfig
+	Members []lbPoolMember `json:"members"`
+}
+
+// lbPoolMember is the static member of a load balancer pool
+type lbPoolMember struct {
+	Name         string `json:"name" tf:"name"`
+	IPAddress    string `json:"ipAddress" tf:"ip_address"`
+	Port         int    `json:"port" tf:"port"`
+	Weight       int    `json:"weight" tf:"weight"`
+	AdminState   string `json:"adminState" tf:"admin_state"`
+	BackupMember bool   `json:"backupMember" tf:"backup_member"`
+}
+
+type lbPoolResponse struct {
+	LoadBalancerPool lbPoolResponseBody `json:"loadBalancerPool"`
+}
+
+type lbPoolResponseBody struct {
+	models.GetSpecificLBPoolResp
+	Config lbPoolConfig `json:"config"`
+}
+
+func lbPoolPath(lbID int) string {
+	return fmt.Sprintf("%s/%d/%s", consts.LoadBalancerPath, lbID, consts.LoadBalancerPoolPath)
+}
+
+// CreateLBPool creates a pool of the load balancer
+func (a *apiService) CreateLBPool(
+	ctx context.Context,
+	lbID int,
+	req lbPoolRequest,
+) (models.CreateLBPoolResp, error) {
+	resp := models.CreateLBPoolResp{}
+	err := a.do(ctx, http.MethodPost, lbPoolPath(lbID), req, nil, &resp)
+
+	return resp, err
+}
+
+// GetLBPool returns a pool of the load balancer
+func (a *apiService) GetLBPool(ctx context.Context, lbID, poolID int) (lbPoolResponse, error) {
+	resp := lbPoolResponse{}
+	err := a.do(ctx, http.MethodGet, fmt.Sprintf("%s/%d", lbPoolPath(lbID), poolID), nil, nil, &resp)
+
+	return resp, err
+}
+
+// UpdateLBPool updates a pool of the load balancer
+func (a *apiService) UpdateLBPool(
+	ctx context.Context,
+	lbID, poolID int,
+	req lbPoolRequest,
+) (models.CreateLBPoolResp, error) {
+	resp := models.CreateLBPoolResp{}
+	err := a.do(ctx, http.MethodPut, fmt.Sprintf("%s/%d", lbPoolPath(lbID), poolID), req, nil, &resp)
+
+	return resp, err
+}
+
+type lbVirtualServerRequest struct {
+	LoadBalancerInstance lbVirtualServerBody `json:"loadBalancerInstance"`
+}
+
+// lbVirtualServerBody is the load balancer virtual server along with the rules,
+// which are not available in cmp-sdk model
+type lbVirtualServerBody struct {
+	models.CreateLBVirtualServersReq
+	Config lbVirtualServerConfig `json:"config"`
+}
+
+type lbVirtualServerConfig struct {
+	models.VirtualServerConfig
+	Rules []lbVirtualServerRule `json:"rules"`
+}
+
+// lbVirtualServerRule is the HTTP rule (L7 policy) of a load balancer virtual server
+type lbVirtualServerRule struct {
+	Phase           string                         `json:"phase" tf:"phase"`
+	MatchStrategy   string                         `json:"matchStrategy" tf:"match_strategy"`
+	MatchConditions []lbVirtualServerRuleCondition `json:"matchConditions" tf:"condition"`
+	Actions         []lbVirtualServerRuleAction    `json:"actions" tf:"action"`
+}
+
+type lbVirtualServerRuleCondition struct {
+	Type          string `json:"type" tf:"type"`
+	MatchType     string `json:"matchType,omitempty" tf:"match_type"`
+	URI           string `json:"uri,omitempty" tf:"uri"`
+	HeaderName    string `json:"headerName,omitempty" tf:"header_name"`
+	HeaderValue   string `json:"headerValue,omitempty" tf:"header_value"`
+	Method        string `json:"method,omitempty" tf:"method"`
+	SourceAddress string `json:"sourceAddress,omitempty" tf:"source_address"`
+	CaseSensitive bool   `json:"caseSensitive" tf:"case_sensitive"`
+	Inverse       bool   `json:"inverse" tf:"inverse"`
+}
+
+type lbVirtualServerRuleAction struct {
+	Type           string `json:"type" tf:"type"`
+	Pool           int    `json:"pool,omitempty" tf:"pool"`
+	RedirectURL    string `json:"redirectUrl,omitempty" tf:"redirect_url"`
+	RedirectStatus string `json:"redirectStatus,omitempty" tf:"redirect_status"`
+	ReplyStatus    string `json:"replyStatus,omitempty" tf:"reply_status"`
+	URI            string `json:"uri,omitempty" tf:"uri"`
+	HeaderName     string `json:"headerName,omitempty" tf:"header_name"`
+	HeaderValue    string `json:"headerValue,omitempty" tf:"header_value"`
+}
+
+type lbVirtualServerResponse struct {
+	LoadBalancerInstance lbVirtualServerResponseBody `json:"loadBalancerInstance"`
+}
+
+type lbVirtualServerResponseBody struct {
+	models.GetSpecificLBVirtualServersResp
+	Config struct {
+		models.VSConfig
+		Rules []lbVirtualServerRule `json:"rules"`
+	} `json:"config"`
+}
+
+func lbVirtualServerPath(lbID int) string {
+	return fmt.Sprintf("%s/%d/%s", consts.LoadBalancerPath, lbID, consts.LoadBalancerVirtualServersPath)
+}
+
+// CreateLBVirtualServer creates a virtual server of the load balancer
+func (a *apiService) CreateLBVirtualServer(
+	ctx context.Context,
+	lbID int,
+	req lbVirtualServerRequest,
+) (models.LBVirtualServersResp, error) {
+	resp := models.LBVirtualServersResp{}
+	err := a.do(ctx, http.MethodPost, lbVirtualServerPath(lbID), req, nil, &resp)
+
+	return resp, err
+}
+
+// GetLBVirtualServer returns a virtual server of the load balancer
+func (a *apiService) GetLBVirtualServer(ctx context.Context, lbID, vsID int) (lbVirtualServerResponse, error) {
+	resp := lbVirtualServerResponse{}
+	err := a.do(ctx, http.MethodGet, fmt.Sprintf("%s/%d", lbVirtualServerPath(lbID), vsID), nil, nil, &resp)
+
+	return resp, err
+}
+
+// UpdateLBVirtualServer updates a virtual server of the load balancer
+func (a *apiService) UpdateLBVirtualServer(
+	ctx context.Context,
+	lbID, vsID int,
+	req lbVirtualServerRequest,
+) (models.LBVirtualServersResp, error) {
+	resp := models.LBVirtualServersResp{}
+	err := a.do(ctx, http.MethodPut, fmt.Sprintf("%s/%d", lbVirtualServerPath(lbID), vsID), req, nil, &resp)
+
+	return resp, err
+}
@@ -0,0 +1,76 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package cmp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	consts "github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/common"
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
+)
+
+type networkResponse struct {
+	Network networkBody `json:"network"`
+}
+
+// networkBody is the network along with the NSX segment configurations, which are
+// not available in cmp-sdk model
+type networkBody struct {
+	models.GetSpecificNetwork
+	Description      string                     `json:"description"`
+	Cidr             string                     `json:"cidr"`
+	Gateway          string                     `json:"gateway"`
+	DNSPrimary       string                     `json:"dnsPrimary"`
+	DNSSecondary     string                     `json:"dnsSecondary"`
+	NoProxy          string                     `json:"noProxy"`
+	SearchDomains    string                     `json:"searchDomains"`
+	ScopeID          string                     `json:"scopeId"`
+	ConnectedGateway string                     `json:"connectedGateway"`
+	VlanIDs          string                     `json:"vlanIDs"`
+	Site             *networkRef                `json:"site"`
+	NetworkDomain    *networkRef                `json:"networkDomain"`
+	NetworkProxy     *networkRef                `json:"networkProxy"`
+	Pool             *networkRef                `json:"pool"`
+	Config           models.CreateNetworkConfig `json:"config"`
+}
+
+// networkRef is the reference to another object of the network. Reference can
+// either be an ID or an object with the ID, and the ID can either be a number or
+// a string, as the group of a shared network
+type networkRef struct {
+	ID string
+}
+
+func (n *networkRef) UnmarshalJSON(data []byte) error {
+	var obj struct {
+		ID json.RawMessage `json:"id"`
+	}
+	id := json.RawMessage(data)
+	if err := json.Unmarshal(data, &obj); err == nil {
+		id = obj.ID
+	}
+	if len(id) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(id, &n.ID); err != nil {
+		var num json.Number
+		if err := json.Unmarshal(id, &num); err != nil {
+			return err
+		}
+		n.ID = num.String()
+	}
+
+	return nil
+}
+
+// GetNetwork returns the network
+func (a *apiService) GetNetwork(ctx context.Context, networkID int) (networkResponse, error) {
+	resp := networkResponse{}
+	err := a.do(ctx, http.MethodGet, fmt.Sprintf("%s/%d", consts.NetworksPath, networkID), nil, nil, &resp)
+
+	return resp, err
+}
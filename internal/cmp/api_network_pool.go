@@ -0,0 +1,162 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package cmp
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	consts "github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/common"
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
+)
+
+const (
+	networkPoolIPsPath = "ips"
+	// networkPoolTypeCode is the type of IP pools managed by CMP itself
+	networkPoolTypeCode = "morpheus"
+)
+
+type networkPoolRequest struct {
+	NetworkPool networkPoolBody `json:"networkPool"`
+}
+
+type networkPoolResponse struct {
+	NetworkPool networkPoolBody `json:"networkPool"`
+}
+
+type networkPoolBody struct {
+	ID         int                  `json:"id,omitempty"`
+	Name       string               `json:"name"`
+	Type       networkPoolType      `json:"type"`
+	Gateway    string               `json:"gateway"`
+	Netmask    string               `json:"netmask"`
+	DNSServers []string             `json:"dnsServers"`
+	IPRanges   []networkPoolIPRange `json:"ipRanges"`
+}
+
+type networkPoolType struct {
+	Code string `json:"code"`
+}
+
+type networkPoolIPRange struct {
+	StartAddress string `json:"startAddress" tf:"start_address"`
+	EndAddress   string `json:"endAddress" tf:"end_address"`
+}
+
+type networkPoolIPRequest struct {
+	NetworkPoolIP networkPoolIPBody `json:"networkPoolIp"`
+}
+
+type networkPoolIPResponse struct {
+	NetworkPoolIP networkPoolIPBody `json:"networkPoolIp"`
+}
+
+type networkPoolIPsResponse struct {
+	NetworkPoolIPs []networkPoolIPBody `json:"networkPoolIps"`
+}
+
+// networkPoolIPBody is the host record of an IP address allocated from the pool
+type networkPoolIPBody struct {
+	ID        int    `json:"id,omitempty"`
+	IPAddress string `json:"ipAddress"`
+	Hostname  string `json:"hostname"`
+	IPType    string `json:"ipType,omitempty"`
+}
+
+func networkPoolPath() string {
+	return fmt.Sprintf("%s/%s", consts.NetworksPath, consts.NetworkPoolPath)
+}
+
+func networkPoolIPPath(poolID int) string {
+	return fmt.Sprintf("%s/%d/%s", networkPoolPath(), poolID, networkPoolIPsPath)
+}
+
+// CreateNetworkPool creates an IP pool
+func (a *apiService) CreateNetworkPool(
+	ctx context.Context,
+	req networkPoolRequest,
+) (models.SuccessOrErrorMessage, error) {
+	resp := models.SuccessOrErrorMessage{}
+	err := a.do(ctx, http.MethodPost, networkPoolPath(), req, nil, &resp)
+
+	return resp, err
+}
+
+// GetNetworkPool returns an IP pool
+func (a *apiService) GetNetworkPool(ctx context.Context, poolID int) (networkPoolResponse, error) {
+	resp := networkPoolResponse{}
+	err := a.do(ctx, http.MethodGet, fmt.Sprintf("%s/%d", networkPoolPath(), poolID), nil, nil, &resp)
+
+	return resp, err
+}
+
+// UpdateNetworkPool updates an IP pool
+func (a *apiService) UpdateNetworkPool(
+	ctx context.Context,
+	poolID int,
+	req networkPoolRequest,
+) (models.SuccessOrErrorMessage, error) {
+	resp := models.SuccessOrErrorMessage{}
+	err := a.do(ctx, http.MethodPut, fmt.Sprintf("%s/%d", networkPoolPath(), poolID), req, nil, &resp)
+
+	return resp, err
+}
+
+// DeleteNetworkPool deletes an IP pool
+func (a *apiService) DeleteNetworkPool(ctx context.Context, poolID int) (models.SuccessOrErrorMessage, error) {
+	resp := models.SuccessOrErrorMessage{}
+	err := a.do(ctx, http.MethodDelete, fmt.Sprintf("%s/%d", networkPoolPath(), poolID), nil, nil, &resp)
+
+	return resp, err
+}
+
+// GetNetworkPoolIPs returns the IP addresses allocated from the pool
+func (a *apiService) GetNetworkPoolIPs(ctx context.Context, poolID int) (networkPoolIPsResponse, error) {
+	resp := networkPoolIPsResponse{}
+	err := a.do(ctx, http.MethodGet, networkPoolIPPath(poolID), nil, map[string]string{
+		maxKey: "-1",
+	}, &resp)
+
+	return resp, err
+}
+
+// CreateNetworkPoolIP allocates an IP address from the pool
+func (a *apiService) CreateNetworkPoolIP(
+	ctx context.Context,
+	poolID int,
+	req networkPoolIPRequest,
+) (models.SuccessOrErrorMessage, error) {
+	resp := models.SuccessOrErrorMessage{}
+	err := a.do(ctx, http.MethodPost, networkPoolIPPath(poolID), req, nil, &resp)
+
+	return resp, err
+}
+
+// GetNetworkPoolIP returns an IP address allocated from the pool
+func (a *apiService) GetNetworkPoolIP(ctx context.Context, poolID, ipID int) (networkPoolIPResponse, error) {
+	resp := networkPoolIPResponse{}
+	err := a.do(ctx, http.MethodGet, fmt.Sprintf("%s/%d", networkPoolIPPath(poolID), ipID), nil, nil, &resp)
+
+	return resp, err
+}
+
+// UpdateNetworkPoolIP updates an IP address allocated from the pool
+func (a *apiService) UpdateNetworkPoolIP(
+	ctx context.Context,
+	poolID, ipID int,
+	req networkPoolIPRequest,
+) (models.SuccessOrErrorMessage, error) {
+	resp := models.SuccessOrErrorMessage{}
+	err := a.do(ctx, http.MethodPut, fmt.Sprintf("%s/%d", networkPoolIPPath(poolID), ipID), req, nil, &resp)
+
+	return resp, err
+}
+
+// DeleteNetworkPoolIP releases an IP address to the pool
+func (a *apiService) DeleteNetworkPoolIP(ctx context.Context, poolID, ipID int) (models.SuccessOrErrorMessage, error) {
+	resp := models.SuccessOrErrorMessage{}
+	err := a.do(ctx, http.MethodDelete, fmt.Sprintf("%s/%d", networkPoolIPPath(poolID), ipID), nil, nil, &resp)
+
+	return resp, err
+}
@@ -0,0 +1,181 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package cmp
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	consts "github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/common"
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
+)
+
+const networkServerFirewallRuleGroupsPath = "firewall-rule-groups"
+
+type securityGroupRequest struct {
+	Group securityGroupBody `json:"group"`
+}
+
+type securityGroupResponse struct {
+	Group securityGroupBody `json:"group"`
+}
+
+// securityGroupBody is the NSX group of the network server. Members of the group
+// are the union of all the criteria
+type securityGroupBody struct {
+	ID          int                 `json:"id,omitempty"`
+	Name        string              `json:"name"`
+	Description string              `json:"description"`
+	ExternalID  string              `json:"externalId,omitempty"`
+	Config      securityGroupConfig `json:"config"`
+}
+
+type securityGroupConfig struct {
+	Tags        []securityGroupTag `json:"tags"`
+	Instances   []models.IDModel   `json:"instances"`
+	Networks    []models.IDModel   `json:"networks"`
+	IPAddresses []string           `json:"ipAddresses"`
+}
+
+// securityGroupTag is the NSX tag criteria of a security group
+type securityGroupTag struct {
+	Scope string `json:"scope" tf:"scope"`
+	Tag   string `json:"tag" tf:"tag"`
+}
+
+type dfwPolicyRequest struct {
+	RuleGroup dfwPolicyBody `json:"ruleGroup"`
+}
+
+type dfwPolicyResponse struct {
+	RuleGroup dfwPolicyBody `json:"ruleGroup"`
+}
+
+// dfwPolicyBody is the distributed firewall policy of the network server along
+// with the rules. Rules are evaluated in the order of sequence number
+type dfwPolicyBody struct {
+	ID           int             `json:"id,omitempty"`
+	Name         string          `json:"name"`
+	Description  string          `json:"description"`
+	Priority     int             `json:"priority"`
+	ExternalType string          `json:"externalType"`
+	ExternalID   string          `json:"externalId,omitempty"`
+	Config       dfwPolicyConfig `json:"config"`
+	Rules        []dfwRuleBody   `json:"rules"`
+}
+
+type dfwPolicyConfig struct {
+	Category string `json:"category"`
+	Stateful bool   `json:"stateful"`
+}
+
+type dfwRuleBody struct {
+	Name           string                   `json:"name"`
+	Description    string                   `json:"description"`
+	Enabled        bool                     `json:"enabled"`
+	SequenceNumber int                      `json:"sequenceNumber"`
+	Action         string                   `json:"action"`
+	Direction      string                   `json:"direction"`
+	Config         routerFirewallRuleConfig `json:"config"`
+}
+
+func networkServerPath(serverID int, path string) string {
+	return fmt.Sprintf("%s/%s/%d/%s", consts.NetworksPath, consts.ServerPath, serverID, path)
+}
+
+// CreateSecurityGroup creates a group on the network server
+func (a *apiService) CreateSecurityGroup(
+	ctx context.Context,
+	serverID int,
+	req securityGroupRequest,
+) (models.SuccessOrErrorMessage, error) {
+	resp := models.SuccessOrErrorMessage{}
+	err := a.do(ctx, http.MethodPost, networkServerPath(serverID, consts.GroupsPath), req, nil, &resp)
+
+	return resp, err
+}
+
+// GetSecurityGroup returns a group of the network server
+func (a *apiService) GetSecurityGroup(ctx context.Context, serverID, groupID int) (securityGroupResponse, error) {
+	resp := securityGroupResponse{}
+	err := a.do(ctx, http.MethodGet,
+		fmt.Sprintf("%s/%d", networkServerPath(serverID, consts.GroupsPath), groupID), nil, nil, &resp)
+
+	return resp, err
+}
+
+// UpdateSecurityGroup updates a group of the network server
+func (a *apiService) UpdateSecurityGroup(
+	ctx context.Context,
+	serverID, groupID int,
+	req securityGroupRequest,
+) (models.SuccessOrErrorMessage, error) {
+	resp := models.SuccessOrErrorMessage{}
+	err := a.do(ctx, http.MethodPut,
+		fmt.Sprintf("%s/%d", networkServerPath(serverID, consts.GroupsPath), groupID), req, nil, &resp)
+
+	return resp, err
+}
+
+// DeleteSecurityGroup deletes a group of the network server
+func (a *apiService) DeleteSecurityGroup(
+	ctx context.Context,
+	serverID, groupID int,
+) (models.SuccessOrErrorMessage, error) {
+	resp := models.SuccessOrErrorMessage{}
+	err := a.do(ctx, http.MethodDelete,
+		fmt.Sprintf("%s/%d", networkServerPath(serverID, consts.GroupsPath), groupID), nil, nil, &resp)
+
+	return resp, err
+}
+
+// CreateDfwPolicy creates a distributed firewall policy on the network server
+func (a *apiService) CreateDfwPolicy(
+	ctx context.Context,
+	serverID int,
+	req dfwPolicyRequest,
+) (models.SuccessOrErrorMessage, error) {
+	resp := models.SuccessOrErrorMessage{}
+	err := a.do(ctx, http.MethodPost,
+		networkServerPath(serverID, networkServerFirewallRuleGroupsPath), req, nil, &resp)
+
+	return resp, err
+}
+
+// GetDfwPolicy returns a distributed firewall policy of the network server
+func (a *apiService) GetDfwPolicy(ctx context.Context, serverID, policyID int) (dfwPolicyResponse, error) {
+	resp := dfwPolicyResponse{}
+	err := a.do(ctx, http.MethodGet,
+		fmt.Sprintf("%s/%d", networkServerPath(serverID, networkServerFirewallRuleGroupsPath), policyID),
+		nil, nil, &resp)
+
+	return resp, err
+}
+
+// UpdateDfwPolicy updates a distributed firewall policy of the network server
+func (a *apiService) UpdateDfwPolicy(
+	ctx context.Context,
+	serverID, policyID int,
+	req dfwPolicyRequest,
+) (models.SuccessOrErrorMessage, error) {
+	resp := models.SuccessOrErrorMessage{}
+	err := a.do(ctx, http.MethodPut,
+		fmt.Sprintf("%s/%d", networkServerPath(serverID, networkServerFirewallRuleGroupsPath), policyID),
+		req, nil, &resp)
+
+	return resp, err
+}
+
+// DeleteDfwPolicy deletes a distributed firewall policy of the network server
+func (a *apiService) DeleteDfwPolicy(
+	ctx context.Context,
+	serverID, policyID int,
+) (models.SuccessOrErrorMessage, error) {
+	resp := models.SuccessOrErrorMessage{}
+	err := a.do(ctx, http.MethodDelete,
+		fmt.Sprintf("%s/%d", networkServerPath(serverID, networkServerFirewallRuleGroupsPath), policyID),
+		nil, nil, &resp)
+
+	return resp, err
+}
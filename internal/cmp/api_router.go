@@ -0,0 +1,278 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package cmp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
+)
+
+const (
+	routersPath            = "networks/routers"
+	firewallRuleGroupsPath = "firewall-rule-groups"
+	firewallRulesPath      = "firewall-rules"
+	routesPath             = "routes"
+	natsPath               = "nats"
+	bgpNeighborsPath       = "bgp-neighbors"
+	interfacesPath         = "interfaces"
+)
+
+type routerResponse struct {
+	NetworkRouter routerBody `json:"networkRouter"`
+}
+
+// routerBody is the router along with the group, type and the gateway config,
+// which are not available in cmp-sdk model
+type routerBody struct {
+	models.GetNetworkRouter
+	Type      routerType                       `json:"type"`
+	Site      *networkRef                      `json:"site"`
+	EnableBGP bool                             `json:"enableBgp"`
+	Config    models.CreateRouterRequestConfig `json:"config"`
+}
+
+type routerType struct {
+	ID   int    `json:"id"`
+	Code string `json:"code"`
+	Name string `json:"name"`
+}
+
+type routerNatResponse struct {
+	NetworkRouterNAT routerNatBody `json:"networkRouterNAT"`
+}
+
+// routerNatBody is the NAT rule of a router along with the config, which is
+// not available in cmp-sdk model
+type routerNatBody struct {
+	models.GetSpecificRouterNat
+	Config models.CreateRouterNatConfig `json:"config"`
+}
+
+type routerBgpNeighborResponse struct {
+	NetworkRouterBgpNeighbor routerBgpNeighborBody `json:"networkRouterBgpNeighbor"`
+}
+
+// routerBgpNeighborBody is the BGP neighbor of a router along with the route
+// filters, which are not available in cmp-sdk model. Remote AS can be either
+// a number or a string in the response
+type routerBgpNeighborBody struct {
+	models.NetworkRouterBgpNeighborBody
+	RemoteAs          json.Number `json:"remoteAs"`
+	RouteFilteringIn  string      `json:"routeFilteringIn"`
+	RouteFilteringOut string      `json:"routeFilteringOut"`
+}
+
+type routerFirewallRuleRequest struct {
+	Rule routerFirewallRuleBody `json:"rule"`
+}
+
+type routerFirewallRuleResponse struct {
+	Rule routerFirewallRuleBody `json:"rule"`
+}
+
+// routerFirewallRuleBody is the gateway firewall rule of a router firewall rule group
+type routerFirewallRuleBody struct {
+	ID          int                      `json:"id,omitempty"`
+	Name        string                   `json:"name"`
+	Description string                   `json:"description"`
+	Enabled     bool                     `json:"enabled"`
+	Priority    int                      `json:"priority"`
+	Action      string                   `json:"action"`
+	Direction   string                   `json:"direction"`
+	RuleGroup   models.IDModel           `json:"ruleGroup"`
+	Config      routerFirewallRuleConfig `json:"config"`
+}
+
+type routerFirewallRuleConfig struct {
+	Sources      []string `json:"sources"`
+	Destinations []string `json:"destinations"`
+	Services     []string `json:"services"`
+	Scope        []string `json:"scope"`
+	Logging      bool     `json:"logging"`
+}
+
+type routerInterfaceRequest struct {
+	NetworkRouterInterface routerInterfaceBody `json:"networkRouterInterface"`
+}
+
+type routerInterfaceResponse struct {
+	NetworkRouterInterface routerInterfaceBody `json:"networkRouterInterface"`
+}
+
+// routerInterfaceBody is the uplink, service or loopback interface of a tier-0 gateway
+type routerInterfaceBody struct {
+	ID            int                   `json:"id,omitempty"`
+	Name          string                `json:"name"`
+	Description   string                `json:"description"`
+	InterfaceType string                `json:"interfaceType"`
+	Network       *models.IDModel       `json:"network,omitempty"`
+	IPAddress     string                `json:"ipAddress"`
+	Cidr          string                `json:"cidr"`
+	ProviderID    string                `json:"providerId,omitempty"`
+	Config        routerInterfaceConfig `json:"config"`
+}
+
+type routerInterfaceConfig struct {
+	EdgeNode string `json:"edgeNode,omitempty"`
+	Mtu      int    `json:"mtu,omitempty"`
+	UrpfMode string `json:"urpfMode"`
+}
+
+func firewallRuleGroupPath(routerID, groupID int) string {
+	return fmt.Sprintf("%s/%d/%s/%d", routersPath, routerID, firewallRuleGroupsPath, groupID)
+}
+
+func firewallRulePath(routerID int) string {
+	return fmt.Sprintf("%s/%d/%s", routersPath, routerID, firewallRulesPath)
+}
+
+// UpdateRouterFirewallRuleGroup updates a firewall rule group of the router
+func (a *apiService) UpdateRouterFirewallRuleGroup(
+	ctx context.Context,
+	routerID, groupID int,
+	req models.CreateRouterFirewallRuleGroupRequest,
+) (models.SuccessOrErrorMessage, error) {
+	resp := models.SuccessOrErrorMessage{}
+	err := a.do(ctx, http.MethodPut, firewallRuleGroupPath(routerID, groupID), req, nil, &resp)
+
+	return resp, err
+}
+
+// CreateRouterFirewallRule creates a firewall rule on a firewall rule group of the router
+func (a *apiService) CreateRouterFirewallRule(
+	ctx context.Context,
+	routerID int,
+	req routerFirewallRuleRequest,
+) (models.SuccessOrErrorMessage, error) {
+	resp := models.SuccessOrErrorMessage{}
+	err := a.do(ctx, http.MethodPost, firewallRulePath(routerID), req, nil, &resp)
+
+	return resp, err
+}
+
+// GetRouterFirewallRule returns a firewall rule of the router
+func (a *apiService) GetRouterFirewallRule(
+	ctx context.Context,
+	routerID, ruleID int,
+) (routerFirewallRuleResponse, error) {
+	resp := routerFirewallRuleResponse{}
+	err := a.do(ctx, http.MethodGet, fmt.Sprintf("%s/%d", firewallRulePath(routerID), ruleID), nil, nil, &resp)
+
+	return resp, err
+}
+
+// UpdateRouterFirewallRule updates a firewall rule of the router
+func (a *apiService) UpdateRouterFirewallRule(
+	ctx context.Context,
+	routerID, ruleID int,
+	req routerFirewallRuleRequest,
+) (models.SuccessOrErrorMessage, error) {
+	resp := models.SuccessOrErrorMessage{}
+	err := a.do(ctx, http.MethodPut, fmt.Sprintf("%s/%d", firewallRulePath(routerID), ruleID), req, nil, &resp)
+
+	return resp, err
+}
+
+// DeleteRouterFirewallRule deletes a firewall rule of the router
+func (a *apiService) DeleteRouterFirewallRule(
+	ctx context.Context,
+	routerID, ruleID int,
+) (models.SuccessOrErrorMessage, error) {
+	resp := models.SuccessOrErrorMessage{}
+	err := a.do(ctx, http.MethodDelete, fmt.Sprintf("%s/%d", firewallRulePath(routerID), ruleID), nil, nil, &resp)
+
+	return resp, err
+}
+
+// UpdateRouterRoute updates a static route of the router
+func (a *apiService) UpdateRouterRoute(
+	ctx context.Context,
+	routerID, routeID int,
+	req models.CreateRouterRoute,
+) (models.SuccessOrErrorMessage, error) {
+	resp := models.SuccessOrErrorMessage{}
+	err := a.do(ctx, http.MethodPut, fmt.Sprintf("%s/%d/%s/%d", routersPath, routerID, routesPath, routeID),
+		req, nil, &resp)
+
+	return resp, err
+}
+
+// GetRouter returns the router
+func (a *apiService) GetRouter(ctx context.Context, routerID int) (routerResponse, error) {
+	resp := routerResponse{}
+	err := a.do(ctx, http.MethodGet, fmt.Sprintf("%s/%d", routersPath, routerID), nil, nil, &resp)
+
+	return resp, err
+}
+
+// GetRouterNat returns a NAT rule of the router
+func (a *apiService) GetRouterNat(ctx context.Context, routerID, natID int) (routerNatResponse, error) {
+	resp := routerNatResponse{}
+	err := a.do(ctx, http.MethodGet, fmt.Sprintf("%s/%d/%s/%d", routersPath, routerID, natsPath, natID),
+		nil, nil, &resp)
+
+	return resp, err
+}
+
+// GetRouterBgpNeighbor returns a BGP neighbor of the router
+func (a *apiService) GetRouterBgpNeighbor(ctx context.Context, routerID, neighborID int) (routerBgpNeighborResponse, error) {
+	resp := routerBgpNeighborResponse{}
+	err := a.do(ctx, http.MethodGet,
+		fmt.Sprintf("%s/%d/%s/%d", routersPath, routerID, bgpNeighborsPath, neighborID), nil, nil, &resp)
+
+	return resp, err
+}
+
+func routerInterfacePath(routerID int) string {
+	return fmt.Sprintf("%s/%d/%s", routersPath, routerID, interfacesPath)
+}
+
+// CreateRouterInterface creates an interface on the router
+func (a *apiService) CreateRouterInterface(
+	ctx context.Context,
+	routerID int,
+	req routerInterfaceRequest,
+) (models.SuccessOrErrorMessage, error) {
+	resp := models.SuccessOrErrorMessage{}
+	err := a.do(ctx, http.MethodPost, routerInterfacePath(routerID), req, nil, &resp)
+
+	return resp, err
+}
+
+// GetRouterInterface returns an interface of the router
+func (a *apiService) GetRouterInterface(
+	ctx context.Context,
+	routerID, interfaceID int,
+) (routerInterfaceResponse, error) {
+	resp := routerInterfaceResponse{}
+	err := a.do(ctx, http.MethodGet, fmt.Sprintf("%s/%d", routerInterfacePath(routerID), interfaceID), nil, nil, &resp)
+
+	return resp, err
+}
+
+// UpdateRouterInterface updates an interface of the router
+func (a *apiService) UpdateRouterInterface(
+	ctx context.Context,
+	routerID, interfaceID int,
+	req routerInterfaceRequest,
+) (models.SuccessOrErrorMessage, error) {
+	resp := models.SuccessOrErrorMessage{}
+	err := a.do(ctx, http.MethodPut, fmt.Sprintf("%s/%d", routerInterfacePath(routerID), interfaceID), req, nil, &resp)
+
+	return resp, err
+}
+
+// DeleteRouterInterface deletes an interface of the router
+func (a *apiService) DeleteRouterInterface(
+	ctx context.Context,
+	routerID, interfaceID int,
+) (models.SuccessOrErrorMessage, error) {
+	resp := models.SuccessOrErrorMessage{}
+	err := a.do(ctx, http.MethodDelete, fmt.Sprintf("%s/%d", routerInterfacePath(routerID), interfaceID), nil, nil, &resp)
+
+	return resp, err
+}
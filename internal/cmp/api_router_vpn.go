@@ -0,0 +1,462 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package cmp
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
+)
+
+const (
+	vpnServicesPath       = "ipsec-vpn-services"
+	vpnLocalEndpointsPath = "ipsec-vpn-local-endpoints"
+	vpnIkeProfilesPath    = "ipsec-vpn-ike-profiles"
+	vpnTunnelProfilesPath = "ipsec-vpn-tunnel-profiles"
+	vpnDpdProfilesPath    = "ipsec-vpn-dpd-profiles"
+	vpnSessionsPath       = "ipsec-vpn-sessions"
+)
+
+type routerVpnServiceRequest struct {
+	VpnService routerVpnServiceBody `json:"networkRouterVpnService"`
+}
+
+type routerVpnServiceResponse struct {
+	VpnService routerVpnServiceBody `json:"networkRouterVpnService"`
+}
+
+// routerVpnServiceBody is the IPsec VPN service of a router
+type routerVpnServiceBody struct {
+	ID          int                    `json:"id,omitempty"`
+	Name        string                 `json:"name"`
+	Description string                 `json:"description"`
+	Enabled     bool                   `json:"enabled"`
+	ProviderID  string                 `json:"providerId,omitempty"`
+	Config      routerVpnServiceConfig `json:"config"`
+}
+
+type routerVpnServiceConfig struct {
+	IkeLogLevel string `json:"ikeLogLevel"`
+	HaSync      bool   `json:"haSync"`
+}
+
+type routerVpnLocalEndpointRequest struct {
+	LocalEndpoint routerVpnLocalEndpointBody `json:"networkRouterVpnLocalEndpoint"`
+}
+
+type routerVpnLocalEndpointResponse struct {
+	LocalEndpoint routerVpnLocalEndpointBody `json:"networkRouterVpnLocalEndpoint"`
+}
+
+// routerVpnLocalEndpointBody is the local endpoint of an IPsec VPN service
+type routerVpnLocalEndpointBody struct {
+	ID           int            `json:"id,omitempty"`
+	Name         string         `json:"name"`
+	Description  string         `json:"description"`
+	VpnService   models.IDModel `json:"vpnService"`
+	LocalAddress string         `json:"localAddress"`
+	LocalID      string         `json:"localId,omitempty"`
+	ProviderID   string         `json:"providerId,omitempty"`
+}
+
+type routerVpnIkeProfileRequest struct {
+	IkeProfile routerVpnIkeProfileBody `json:"networkRouterVpnIkeProfile"`
+}
+
+type routerVpnIkeProfileResponse struct {
+	IkeProfile routerVpnIkeProfileBody `json:"networkRouterVpnIkeProfile"`
+}
+
+// routerVpnIkeProfileBody is the IKE profile used for the phase 1 negotiation
+// of IPsec VPN sessions
+type routerVpnIkeProfileBody struct {
+	ID                   int      `json:"id,omitempty"`
+	Name                 string   `json:"name"`
+	Description          string   `json:"description"`
+	IkeVersion           string   `json:"ikeVersion"`
+	EncryptionAlgorithms []string `json:"encryptionAlgorithms"`
+	DigestAlgorithms     []string `json:"digestAlgorithms"`
+	DhGroups             []string `json:"dhGroups"`
+	SaLifeTime           int      `json:"saLifeTime"`
+	ProviderID           string   `json:"providerId,omitempty"`
+}
+
+type routerVpnTunnelProfileRequest struct {
+	TunnelProfile routerVpnTunnelProfileBody `json:"networkRouterVpnTunnelProfile"`
+}
+
+type routerVpnTunnelProfileResponse struct {
+	TunnelProfile routerVpnTunnelProfileBody `json:"networkRouterVpnTunnelProfile"`
+}
+
+// routerVpnTunnelProfileBody is the IPsec tunnel profile used for the phase 2
+// negotiation of IPsec VPN sessions
+type routerVpnTunnelProfileBody struct {
+	ID                          int      `json:"id,omitempty"`
+	Name                        string   `json:"name"`
+	Description                 string   `json:"description"`
+	EncryptionAlgorithms        []string `json:"encryptionAlgorithms"`
+	DigestAlgorithms            []string `json:"digestAlgorithms"`
+	DhGroups                    []string `json:"dhGroups"`
+	EnablePerfectForwardSecrecy bool     `json:"enablePerfectForwardSecrecy"`
+	SaLifeTime                  int      `json:"saLifeTime"`
+	DfPolicy                    string   `json:"dfPolicy"`
+	ProviderID                  string   `json:"providerId,omitempty"`
+}
+
+type routerVpnDpdProfileRequest struct {
+	DpdProfile routerVpnDpdProfileBody `json:"networkRouterVpnDpdProfile"`
+}
+
+type routerVpnDpdProfileResponse struct {
+	DpdProfile routerVpnDpdProfileBody `json:"networkRouterVpnDpdProfile"`
+}
+
+// routerVpnDpdProfileBody is the dead peer detection profile of IPsec VPN sessions
+type routerVpnDpdProfileBody struct {
+	ID               int    `json:"id,omitempty"`
+	Name             string `json:"name"`
+	Description      string `json:"description"`
+	Enabled          bool   `json:"enabled"`
+	DpdProbeMode     string `json:"dpdProbeMode"`
+	DpdProbeInterval int    `json:"dpdProbeInterval"`
+	RetryCount       int    `json:"retryCount"`
+	ProviderID       string `json:"providerId,omitempty"`
+}
+
+type routerVpnSessionRequest struct {
+	Session routerVpnSessionBody `json:"networkRouterVpnSession"`
+}
+
+type routerVpnSessionResponse struct {
+	Session routerVpnSessionBody `json:"networkRouterVpnSession"`
+}
+
+// routerVpnSessionBody is the policy based or route based IPsec VPN session.
+// Pre-shared key is not returned in the response
+type routerVpnSessionBody struct {
+	ID                       int                    `json:"id,omitempty"`
+	Name                     string                 `json:"name"`
+	Description              string                 `json:"description"`
+	Enabled                  bool                   `json:"enabled"`
+	SessionType              string                 `json:"sessionType"`
+	VpnService               models.IDModel         `json:"vpnService"`
+	LocalEndpoint            models.IDModel         `json:"localEndpoint"`
+	IkeProfile               *models.IDModel        `json:"ikeProfile,omitempty"`
+	TunnelProfile            *models.IDModel        `json:"tunnelProfile,omitempty"`
+	DpdProfile               *models.IDModel        `json:"dpdProfile,omitempty"`
+	PeerAddress              string                 `json:"peerAddress"`
+	PeerID                   string                 `json:"peerId,omitempty"`
+	Psk                      string                 `json:"psk,omitempty"`
+	ConnectionInitiationMode string                 `json:"connectionInitiationMode"`
+	TunnelInterfaceCidr      string                 `json:"tunnelInterfaceCidr,omitempty"`
+	Rules                    []routerVpnSessionRule `json:"rules,omitempty"`
+	ProviderID               string                 `json:"providerId,omitempty"`
+}
+
+// routerVpnSessionRule is the rule of a policy based IPsec VPN session
+type routerVpnSessionRule struct {
+	Sources      []string `json:"sources" tf:"sources"`
+	Destinations []string `json:"destinations" tf:"destinations"`
+	Action       string   `json:"action" tf:"action"`
+}
+
+func routerVpnPath(routerID int, path string) string {
+	return fmt.Sprintf("%s/%d/%s", routersPath, routerID, path)
+}
+
+// CreateRouterVpnService creates an IPsec VPN service on the router
+func (a *apiService) CreateRouterVpnService(
+	ctx context.Context,
+	routerID int,
+	req routerVpnServiceRequest,
+) (models.SuccessOrErrorMessage, error) {
+	resp := models.SuccessOrErrorMessage{}
+	err := a.do(ctx, http.MethodPost, routerVpnPath(routerID, vpnServicesPath), req, nil, &resp)
+
+	return resp, err
+}
+
+// GetRouterVpnService returns an IPsec VPN service of the router
+func (a *apiService) GetRouterVpnService(
+	ctx context.Context,
+	routerID, serviceID int,
+) (routerVpnServiceResponse, error) {
+	resp := routerVpnServiceResponse{}
+	err := a.do(ctx, http.MethodGet,
+		fmt.Sprintf("%s/%d", routerVpnPath(routerID, vpnServicesPath), serviceID), nil, nil, &resp)
+
+	return resp, err
+}
+
+// UpdateRouterVpnService updates an IPsec VPN service of the router
+func (a *apiService) UpdateRouterVpnService(
+	ctx context.Context,
+	routerID, serviceID int,
+	req routerVpnServiceRequest,
+) (models.SuccessOrErrorMessage, error) {
+	resp := models.SuccessOrErrorMessage{}
+	err := a.do(ctx, http.MethodPut,
+		fmt.Sprintf("%s/%d", routerVpnPath(routerID, vpnServicesPath), serviceID), req, nil, &resp)
+
+	return resp, err
+}
+
+// DeleteRouterVpnService deletes an IPsec VPN service of the router
+func (a *apiService) DeleteRouterVpnService(
+	ctx context.Context,
+	routerID, serviceID int,
+) (models.SuccessOrErrorMessage, error) {
+	resp := models.SuccessOrErrorMessage{}
+	err := a.do(ctx, http.MethodDelete,
+		fmt.Sprintf("%s/%d", routerVpnPath(routerID, vpnServicesPath), serviceID), nil, nil, &resp)
+
+	return resp, err
+}
+
+// CreateRouterVpnLocalEndpoint creates a local endpoint of an IPsec VPN service
+func (a *apiService) CreateRouterVpnLocalEndpoint(
+	ctx context.Context,
+	routerID int,
+	req routerVpnLocalEndpointRequest,
+) (models.SuccessOrErrorMessage, error) {
+	resp := models.SuccessOrErrorMessage{}
+	err := a.do(ctx, http.MethodPost, routerVpnPath(routerID, vpnLocalEndpointsPath), req, nil, &resp)
+
+	return resp, err
+}
+
+// GetRouterVpnLocalEndpoint returns a local endpoint of an IPsec VPN service
+func (a *apiService) GetRouterVpnLocalEndpoint(
+	ctx context.Context,
+	routerID, endpointID int,
+) (routerVpnLocalEndpointResponse, error) {
+	resp := routerVpnLocalEndpointResponse{}
+	err := a.do(ctx, http.MethodGet,
+		fmt.Sprintf("%s/%d", routerVpnPath(routerID, vpnLocalEndpointsPath), endpointID), nil, nil, &resp)
+
+	return resp, err
+}
+
+// UpdateRouterVpnLocalEndpoint updates a local endpoint of an IPsec VPN service
+func (a *apiService) UpdateRouterVpnLocalEndpoint(
+	ctx context.Context,
+	routerID, endpointID int,
+	req routerVpnLocalEndpointRequest,
+) (models.SuccessOrErrorMessage, error) {
+	resp := models.SuccessOrErrorMessage{}
+	err := a.do(ctx, http.MethodPut,
+		fmt.Sprintf("%s/%d", routerVpnPath(routerID, vpnLocalEndpointsPath), endpointID), req, nil, &resp)
+
+	return resp, err
+}
+
+// DeleteRouterVpnLocalEndpoint deletes a local endpoint of an IPsec VPN service
+func (a *apiService) DeleteRouterVpnLocalEndpoint(
+	ctx context.Context,
+	routerID, endpointID int,
+) (models.SuccessOrErrorMessage, error) {
+	resp := models.SuccessOrErrorMessage{}
+	err := a.do(ctx, http.MethodDelete,
+		fmt.Sprintf("%s/%d", routerVpnPath(routerID, vpnLocalEndpointsPath), endpointID), nil, nil, &resp)
+
+	return resp, err
+}
+
+// CreateRouterVpnIkeProfile creates an IKE profile for IPsec VPN sessions of the router
+func (a *apiService) CreateRouterVpnIkeProfile(
+	ctx context.Context,
+	routerID int,
+	req routerVpnIkeProfileRequest,
+) (models.SuccessOrErrorMessage, error) {
+	resp := models.SuccessOrErrorMessage{}
+	err := a.do(ctx, http.MethodPost, routerVpnPath(routerID, vpnIkeProfilesPath), req, nil, &resp)
+
+	return resp, err
+}
+
+// GetRouterVpnIkeProfile returns an IKE profile of the router
+func (a *apiService) GetRouterVpnIkeProfile(
+	ctx context.Context,
+	routerID, profileID int,
+) (routerVpnIkeProfileResponse, error) {
+	resp := routerVpnIkeProfileResponse{}
+	err := a.do(ctx, http.MethodGet,
+		fmt.Sprintf("%s/%d", routerVpnPath(routerID, vpnIkeProfilesPath), profileID), nil, nil, &resp)
+
+	return resp, err
+}
+
+// UpdateRouterVpnIkeProfile updates an IKE profile of the router
+func (a *apiService) UpdateRouterVpnIkeProfile(
+	ctx context.Context,
+	routerID, profileID int,
+	req routerVpnIkeProfileRequest,
+) (models.SuccessOrErrorMessage, error) {
+	resp := models.SuccessOrErrorMessage{}
+	err := a.do(ctx, http.MethodPut,
+		fmt.Sprintf("%s/%d", routerVpnPath(routerID, vpnIkeProfilesPath), profileID), req, nil, &resp)
+
+	return resp, err
+}
+
+// DeleteRouterVpnIkeProfile deletes an IKE profile of the router
+func (a *apiService) DeleteRouterVpnIkeProfile(
+	ctx context.Context,
+	routerID, profileID int,
+) (models.SuccessOrErrorMessage, error) {
+	resp := models.SuccessOrErrorMessage{}
+	err := a.do(ctx, http.MethodDelete,
+		fmt.Sprintf("%s/%d", routerVpnPath(routerID, vpnIkeProfilesPath), profileID), nil, nil, &resp)
+
+	return resp, err
+}
+
+// CreateRouterVpnTunnelProfile creates an IPsec tunnel profile for IPsec VPN sessions of the router
+func (a *apiService) CreateRouterVpnTunnelProfile(
+	ctx context.Context,
+	routerID int,
+	req routerVpnTunnelProfileRequest,
+) (models.SuccessOrErrorMessage, error) {
+	resp := models.SuccessOrErrorMessage{}
+	err := a.do(ctx, http.MethodPost, routerVpnPath(routerID, vpnTunnelProfilesPath), req, nil, &resp)
+
+	return resp, err
+}
+
+// GetRouterVpnTunnelProfile returns an IPsec tunnel profile of the router
+func (a *apiService) GetRouterVpnTunnelProfile(
+	ctx context.Context,
+	routerID, profileID int,
+) (routerVpnTunnelProfileResponse, error) {
+	resp := routerVpnTunnelProfileResponse{}
+	err := a.do(ctx, http.MethodGet,
+		fmt.Sprintf("%s/%d", routerVpnPath(routerID, vpnTunnelProfilesPath), profileID), nil, nil, &resp)
+
+	return resp, err
+}
+
+// UpdateRouterVpnTunnelProfile updates an IPsec tunnel profile of the router
+func (a *apiService) UpdateRouterVpnTunnelProfile(
+	ctx context.Context,
+	routerID, profileID int,
+	req routerVpnTunnelProfileRequest,
+) (models.SuccessOrErrorMessage, error) {
+	resp := models.SuccessOrErrorMessage{}
+	err := a.do(ctx, http.MethodPut,
+		fmt.Sprintf("%s/%d", routerVpnPath(routerID, vpnTunnelProfilesPath), profileID), req, nil, &resp)
+
+	return resp, err
+}
+
+// DeleteRouterVpnTunnelProfile deletes an IPsec tunnel profile of the router
+func (a *apiService) DeleteRouterVpnTunnelProfile(
+	ctx context.Context,
+	routerID, profileID int,
+) (models.SuccessOrErrorMessage, error) {
+	resp := models.SuccessOrErrorMessage{}
+	err := a.do(ctx, http.MethodDelete,
+		fmt.Sprintf("%s/%d", routerVpnPath(routerID, vpnTunnelProfilesPath), profileID), nil, nil, &resp)
+
+	return resp, err
+}
+
+// CreateRouterVpnDpdProfile creates a DPD profile for IPsec VPN sessions of the router
+func (a *apiService) CreateRouterVpnDpdProfile(
+	ctx context.Context,
+	routerID int,
+	req routerVpnDpdProfileRequest,
+) (models.SuccessOrErrorMessage, error) {
+	resp := models.SuccessOrErrorMessage{}
+	err := a.do(ctx, http.MethodPost, routerVpnPath(routerID, vpnDpdProfilesPath), req, nil, &resp)
+
+	return resp, err
+}
+
+// GetRouterVpnDpdProfile returns a DPD profile of the router
+func (a *apiService) GetRouterVpnDpdProfile(
+	ctx context.Context,
+	routerID, profileID int,
+) (routerVpnDpdProfileResponse, error) {
+	resp := routerVpnDpdProfileResponse{}
+	err := a.do(ctx, http.MethodGet,
+		fmt.Sprintf("%s/%d", routerVpnPath(routerID, vpnDpdProfilesPath), profileID), nil, nil, &resp)
+
+	return resp, err
+}
+
+// UpdateRouterVpnDpdProfile updates a DPD profile of the router
+func (a *apiService) UpdateRouterVpnDpdProfile(
+	ctx context.Context,
+	routerID, profileID int,
+	req routerVpnDpdProfileRequest,
+) (models.SuccessOrErrorMessage, error) {
+	resp := models.SuccessOrErrorMessage{}
+	err := a.do(ctx, http.MethodPut,
+		fmt.Sprintf("%s/%d", routerVpnPath(routerID, vpnDpdProfilesPath), profileID), req, nil, &resp)
+
+	return resp, err
+}
+
+// DeleteRouterVpnDpdProfile deletes a DPD profile of the router
+func (a *apiService) DeleteRouterVpnDpdProfile(
+	ctx context.Context,
+	routerID, profileID int,
+) (models.SuccessOrErrorMessage, error) {
+	resp := models.SuccessOrErrorMessage{}
+	err := a.do(ctx, http.MethodDelete,
+		fmt.Sprintf("%s/%d", routerVpnPath(routerID, vpnDpdProfilesPath), profileID), nil, nil, &resp)
+
+	return resp, err
+}
+
+// CreateRouterVpnSession creates an IPsec VPN session on the router
+func (a *apiService) CreateRouterVpnSession(
+	ctx context.Context,
+	routerID int,
+	req routerVpnSessionRequest,
+) (models.SuccessOrErrorMessage, error) {
+	resp := models.SuccessOrErrorMessage{}
+	err := a.do(ctx, http.MethodPost, routerVpnPath(routerID, vpnSessionsPath), req, nil, &resp)
+
+	return resp, err
+}
+
+// GetRouterVpnSession returns an IPsec VPN session of the router
+func (a *apiService) GetRouterVpnSession(
+	ctx context.Context,
+	routerID, sessionID int,
+) (routerVpnSessionResponse, error) {
+	resp := routerVpnSessionResponse{}
+	err := a.do(ctx, http.MethodGet,
+		fmt.Sprintf("%s/%d", routerVpnPath(routerID, vpnSessionsPath), sessionID), nil, nil, &resp)
+
+	return resp, err
+}
+
+// UpdateRouterVpnSession updates an IPsec VPN session of the router
+func (a *apiService) UpdateRouterVpnSession(
+	ctx context.Context,
+	routerID, sessionID int,
+	req routerVpnSessionRequest,
+) (models.SuccessOrErrorMessage, error) {
+	resp := models.SuccessOrErrorMessage{}
+	err := a.do(ctx, http.MethodPut,
+		fmt.Sprintf("%s/%d", routerVpnPath(routerID, vpnSessionsPath), sessionID), req, nil, &resp)
+
+	return resp, err
+}
+
+// DeleteRouterVpnSession deletes an IPsec VPN session of the router
+func (a *apiService) DeleteRouterVpnSession(
+	ctx context.Context,
+	routerID, sessionID int,
+) (models.SuccessOrErrorMessage, error) {
+	resp := models.SuccessOrErrorMessage{}
+	err := a.do(ctx, http.MethodDelete,
+		fmt.Sprintf("%s/%d", routerVpnPath(routerID, vpnSessionsPath), sessionID), nil, nil, &resp)
+
+	return resp, err
+}
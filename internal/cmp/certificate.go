@@ -0,0 +1,144 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package cmp
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/tshihad/tftags"
+)
+
+// tfCertificate is the terraform model for hpegl_vmaas_certificate
+type tfCertificate struct {
+	ID          int    `tf:"id,computed"`
+	Name        string `tf:"name"`
+	Description string `tf:"description"`
+	Certificate string `tf:"certificate"`
+	PrivateKey  string `tf:"private_key"`
+	Chain       string `tf:"chain"`
+}
+
+// certificate implements functions related to SSL certificates, which can be
+// used by load balancer virtual servers
+type certificate struct {
+	api *apiService
+}
+
+func newCertificate(api *apiService) *certificate {
+	return &certificate{
+		api: api,
+	}
+}
+
+func (c *certificate) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
+	c.api.setMeta(meta)
+	var tfCert tfCertificate
+	if err := tftags.Get(d, &tfCert); err != nil {
+		return err
+	}
+
+	resp, err := c.api.GetCertificate(ctx, tfCert.ID)
+	if err != nil {
+		return handleNotFound(d, err, "Certificate")
+	}
+
+	values := map[string]interface{}{
+		"name":        resp.Certificate.Name,
+		"description": resp.Certificate.Description,
+	}
+	// certificate is not returned in the response, so expiry is taken from
+	// the certificate in the state
+	if x509Cert, err := utils.ParseCertificate(tfCert.Certificate); err == nil {
+		values["common_name"] = x509Cert.Subject.CommonName
+		values["expires_at"] = x509Cert.NotAfter.UTC().Format(time.RFC3339)
+		values["expired"] = time.Now().After(x509Cert.NotAfter)
+	}
+
+	return setState(d, values)
+}
+
+func (c *certificate) Create(ctx context.Context, d *utils.Data, meta interface{}) error {
+	c.api.setMeta(meta)
+	var tfCert tfCertificate
+	if err := tftags.Get(d, &tfCert); err != nil {
+		return err
+	}
+
+	resp, err := c.api.CreateCertificate(ctx, certificateToRequest(tfCert))
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "creating certificate")
+	}
+	tfCert.ID = resp.ID
+
+	return tftags.Set(d, tfCert)
+}
+
+func (c *certificate) Update(ctx context.Context, d *utils.Data, meta interface{}) error {
+	c.api.setMeta(meta)
+	var tfCert tfCertificate
+	if err := tftags.Get(d, &tfCert); err != nil {
+		return err
+	}
+
+	// certificate and private key are not updated, since those are force new
+	req := certificateRequest{
+		Certificate: certificateBody{
+			Name:        tfCert.Name,
+			Description: tfCert.Description,
+			CertType:    certTypeCertificate,
+		},
+	}
+	resp, err := c.api.UpdateCertificate(ctx, tfCert.ID, req)
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "updating certificate")
+	}
+
+	return nil
+}
+
+func (c *certificate) Delete(ctx context.Context, d *utils.Data, meta interface{}) error {
+	c.api.setMeta(meta)
+	var tfCert tfCertificate
+	if err := tftags.Get(d, &tfCert); err != nil {
+		return err
+	}
+
+	resp, err := c.api.DeleteCertificate(ctx, tfCert.ID)
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "deleting certificate")
+	}
+
+	return nil
+}
+
+// certificateToRequest populates the certificate request. Chain is uploaded
+// along with the certificate
+func certificateToRequest(tfCert tfCertificate) certificateRequest {
+	certFile := strings.TrimSpace(tfCert.Certificate)
+	if chain := strings.TrimSpace(tfCert.Chain); chain != "" {
+		certFile += "\n" + chain
+	}
+
+	return certificateRequest{
+		Certificate: certificateBody{
+			Name:        tfCert.Name,
+			Description: tfCert.Description,
+			CertType:    certTypeCertificate,
+			CertFile:    certFile + "\n",
+			KeyFile:     tfCert.PrivateKey,
+		},
+	}
+}
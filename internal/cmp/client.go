@@ -1,4 +1,4 @@
-// (C) Copyright 2021-2022 Hewlett Packard Enterprise Development LP
+// (C) Copyright 2021-2024 Hewlett Packard Enterprise Development LP
 
 package cmp
 
@@ -9,18 +9,34 @@ import apiClient "github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
 type Client struct {
 	Instance                  Resource
 	InstanceClone             Resource
+	InstanceSnapshot          Resource
 	Router                    Resource
 	ResNetwork                Resource
+	ResNetworkPool            Resource
+	IPAddress                 Resource
 	RouterNat                 Resource
 	RouterFirewallRuleGroup   Resource
+	RouterFirewallRule        Resource
 	RouterRoute               Resource
 	RouterBgpNeighbor         Resource
+	RouterInterface           Resource
+	RouterVpnService          Resource
+	RouterVpnLocalEndpoint    Resource
+	RouterVpnIkeProfile       Resource
+	RouterVpnIpsecProfile     Resource
+	RouterVpnDpdProfile       Resource
+	RouterVpnSession          Resource
+	SecurityGroup             Resource
+	DistributedFirewallPolicy Resource
 	LoadBalancer              Resource
 	DhcpServer                Resource
+	DhcpStaticBinding         Resource
+	DhcpRelay                 Resource
 	LoadBalancerMonitor       Resource
 	LoadBalancerProfile       Resource
 	LoadBalancerPool          Resource
 	LoadBalancerVirtualServer Resource
+	Certificate               Resource
 	Network                   DataSource
 	NetworkType               DataSource
 	NetworkPool               DataSource
@@ -51,36 +67,62 @@ type Client struct {
 
 // NewClient returns configured client
 func NewClient(client *apiClient.APIClient, cfg apiClient.Configuration) *Client {
+	api := newAPIService(cfg)
+
 	return &Client{
 		// Resources
 		Instance: newInstance(
 			&apiClient.InstancesAPIService{Client: client, Cfg: cfg},
 			&apiClient.ServersAPIService{Client: client, Cfg: cfg},
+			api,
 		),
 		InstanceClone: newInstanceClone(
 			&apiClient.InstancesAPIService{Client: client, Cfg: cfg},
 			&apiClient.ServersAPIService{Client: client, Cfg: cfg},
+			api,
+		),
+		InstanceSnapshot: newInstanceSnapshot(
+			&apiClient.InstancesAPIService{Client: client, Cfg: cfg},
+			api,
 		),
 		ResNetwork: newResNetwork(
 			&apiClient.NetworksAPIService{Client: client, Cfg: cfg},
 			&apiClient.RouterAPIService{Client: client, Cfg: cfg},
+			api,
 		),
+		ResNetworkPool: newResNetworkPool(api),
+		IPAddress:      newIPAddress(api),
 		LoadBalancer: newLoadBalancer(
 			&apiClient.LoadBalancerAPIService{Client: client, Cfg: cfg},
 			&apiClient.RouterAPIService{Client: client, Cfg: cfg}),
 		DhcpServer: newDhcpServer(
 			&apiClient.DhcpServerAPIService{Client: client, Cfg: cfg},
 			&apiClient.RouterAPIService{Client: client, Cfg: cfg}),
+		DhcpStaticBinding:         newDhcpStaticBinding(api),
+		DhcpRelay:                 newDhcpRelay(&apiClient.RouterAPIService{Client: client, Cfg: cfg}, api),
 		LoadBalancerMonitor:       newLoadBalancerMonitor(&apiClient.LoadBalancerAPIService{Client: client, Cfg: cfg}),
 		LoadBalancerProfile:       newLoadBalancerProfile(&apiClient.LoadBalancerAPIService{Client: client, Cfg: cfg}),
-		LoadBalancerPool:          newLoadBalancerPool(&apiClient.LoadBalancerAPIService{Client: client, Cfg: cfg}),
-		LoadBalancerVirtualServer: newLoadBalancerVirtualServer(&apiClient.LoadBalancerAPIService{Client: client, Cfg: cfg}),
+		LoadBalancerPool:          newLoadBalancerPool(&apiClient.LoadBalancerAPIService{Client: client, Cfg: cfg}, api),
+		LoadBalancerVirtualServer: newLoadBalancerVirtualServer(&apiClient.LoadBalancerAPIService{Client: client, Cfg: cfg}, api),
+		Certificate:               newCertificate(api),
+
+		Router:                  newRouter(&apiClient.RouterAPIService{Client: client, Cfg: cfg}, api),
+		RouterNat:               newRouterNat(&apiClient.RouterAPIService{Client: client, Cfg: cfg}, api),
+		RouterFirewallRuleGroup: newRouterFirewallRuleGroup(&apiClient.RouterAPIService{Client: client, Cfg: cfg}, api),
+		RouterFirewallRule:      newRouterFirewallRule(api),
+		RouterRoute:             newRouterRoute(&apiClient.RouterAPIService{Client: client, Cfg: cfg}, api),
+		RouterBgpNeighbor:       newRouterBgpNeighbor(&apiClient.RouterAPIService{Client: client, Cfg: cfg}, api),
+		RouterInterface:         newRouterInterface(&apiClient.RouterAPIService{Client: client, Cfg: cfg}, api),
+		RouterVpnService:        newRouterVpnService(&apiClient.RouterAPIService{Client: client, Cfg: cfg}, api),
+		RouterVpnLocalEndpoint:  newRouterVpnLocalEndpoint(api),
+		RouterVpnIkeProfile:     newRouterVpnIkeProfile(&apiClient.RouterAPIService{Client: client, Cfg: cfg}, api),
+		RouterVpnIpsecProfile:   newRouterVpnIpsecProfile(&apiClient.RouterAPIService{Client: client, Cfg: cfg}, api),
+		RouterVpnDpdProfile:     newRouterVpnDpdProfile(&apiClient.RouterAPIService{Client: client, Cfg: cfg}, api),
+		RouterVpnSession:        newRouterVpnSession(api),
 
-		Router:                  newRouter(&apiClient.RouterAPIService{Client: client, Cfg: cfg}),
-		RouterNat:               newRouterNat(&apiClient.RouterAPIService{Client: client, Cfg: cfg}),
-		RouterFirewallRuleGroup: newRouterFirewallRuleGroup(&apiClient.RouterAPIService{Client: client, Cfg: cfg}),
-		RouterRoute:             newRouterRoute(&apiClient.RouterAPIService{Client: client, Cfg: cfg}),
-		RouterBgpNeighbor:       newRouterBgpNeighbor(&apiClient.RouterAPIService{Client: client, Cfg: cfg}),
+		SecurityGroup: newSecurityGroup(&apiClient.RouterAPIService{Client: client, Cfg: cfg}, api),
+		DistributedFirewallPolicy: newDistributedFirewallPolicy(&apiClient.RouterAPIService{Client: client, Cfg: cfg},
+			api),
 		// Datasource
 		Network:       newNetwork(&apiClient.NetworksAPIService{Client: client, Cfg: cfg}),
 		NetworkType:   newNetworkType(&apiClient.NetworksAPIService{Client: client, Cfg: cfg}),
@@ -1,4 +1,4 @@
-// (C) Copyright 2021-2022 Hewlett Packard Enterprise Development LP
+// (C) Copyright 2021-2024 Hewlett Packard Enterprise Development LP
 
 package cmp
 
@@ -18,12 +18,20 @@ const (
 	maxKey           = "max"
 	externalNameKey  = "externalName"
 	filterTypeKey    = "filterType"
-	// retry related constants
-	maxTimeout = time.Hour * 2
+	// group ID of the network shared across all the groups
+	sharedGroupID = "shared"
+	// retry related constants. Timeouts are configured from the resource
+	retryInitialDelay = time.Second * 15
+	retryDelay        = time.Second * 30
+	// maximum delay in between the retries while polling with backoff
+	maxRetryDelay = time.Minute
+	// maximum number of consecutive errors allowed while waiting on a state
+	maxErrCount = 3
 	// router consts
 	tier0GatewayType             = "Tier-0 Gateway"
 	tier1GatewayType             = "Tier-1 Gateway"
 	routerFirewallExternalPolicy = "GatewayPolicy"
+	dfwExternalPolicy            = "SecurityPolicy"
 	syncedTypeValue              = "Synced"
 
 	// load balancer consts
@@ -0,0 +1,144 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package cmp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/tshihad/tftags"
+)
+
+// tfDhcpRelay is the terraform model for hpegl_vmaas_dhcp_relay
+type tfDhcpRelay struct {
+	ID              int      `tf:"id,computed"`
+	NetworkServerID int      `tf:"network_server_id,computed"`
+	Name            string   `tf:"name"`
+	ServerAddresses []string `tf:"server_addresses"`
+	ProviderID      string   `tf:"provider_id,computed"`
+}
+
+// dhcpRelay implements functions related to DHCP relays of the NSX network server
+type dhcpRelay struct {
+	rClient *client.RouterAPIService
+	api     *apiService
+}
+
+func newDhcpRelay(routerClient *client.RouterAPIService, api *apiService) *dhcpRelay {
+	return &dhcpRelay{
+		rClient: routerClient,
+		api:     api,
+	}
+}
+
+func (r *dhcpRelay) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
+	r.api.setMeta(meta)
+	var tfRelay tfDhcpRelay
+	if err := tftags.Get(d, &tfRelay); err != nil {
+		return err
+	}
+
+	if tfRelay.NetworkServerID == 0 {
+		serverID, err := r.networkServerID(ctx, meta)
+		if err != nil {
+			return err
+		}
+		tfRelay.NetworkServerID = serverID
+	}
+	resp, err := r.api.GetDhcpRelay(ctx, tfRelay.NetworkServerID, tfRelay.ID)
+	if err != nil {
+		return handleNotFound(d, err, "DHCP relay")
+	}
+	relay := resp.Relay
+
+	return setState(d, map[string]interface{}{
+		"network_server_id": tfRelay.NetworkServerID,
+		"name":              relay.Name,
+		"server_addresses":  relay.ServerAddresses,
+		"provider_id":       relay.ExternalID,
+	})
+}
+
+// Import DHCP relay with the ID in the format '<network_server_id>/<relay_id>'
+func (r *dhcpRelay) Import(ctx context.Context, d *utils.Data, meta interface{}) error {
+	return importChild(ctx, d, meta, "network_server_id", r)
+}
+
+func (r *dhcpRelay) Create(ctx context.Context, d *utils.Data, meta interface{}) error {
+	r.api.setMeta(meta)
+	var tfRelay tfDhcpRelay
+	if err := tftags.Get(d, &tfRelay); err != nil {
+		return err
+	}
+
+	serverID, err := r.networkServerID(ctx, meta)
+	if err != nil {
+		return err
+	}
+	resp, err := r.api.CreateDhcpRelay(ctx, serverID, dhcpRelayToRequest(tfRelay))
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "creating DHCP relay")
+	}
+	tfRelay.ID = resp.ID
+	tfRelay.NetworkServerID = serverID
+
+	return tftags.Set(d, tfRelay)
+}
+
+func (r *dhcpRelay) Update(ctx context.Context, d *utils.Data, meta interface{}) error {
+	r.api.setMeta(meta)
+	var tfRelay tfDhcpRelay
+	if err := tftags.Get(d, &tfRelay); err != nil {
+		return err
+	}
+
+	resp, err := r.api.UpdateDhcpRelay(ctx, tfRelay.NetworkServerID, tfRelay.ID, dhcpRelayToRequest(tfRelay))
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "updating DHCP relay")
+	}
+
+	return nil
+}
+
+func (r *dhcpRelay) Delete(ctx context.Context, d *utils.Data, meta interface{}) error {
+	r.api.setMeta(meta)
+	var tfRelay tfDhcpRelay
+	if err := tftags.Get(d, &tfRelay); err != nil {
+		return err
+	}
+
+	resp, err := r.api.DeleteDhcpRelay(ctx, tfRelay.NetworkServerID, tfRelay.ID)
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "deleting DHCP relay")
+	}
+
+	return nil
+}
+
+// networkServerID returns the NSX network server, which owns the DHCP servers
+// and the DHCP relays
+func (r *dhcpRelay) networkServerID(ctx context.Context, meta interface{}) (int, error) {
+	setMeta(meta, r.rClient.Client)
+
+	return getNsxNetworkServerID(ctx, r.rClient)
+}
+
+func dhcpRelayToRequest(tfRelay tfDhcpRelay) dhcpRelayRequest {
+	return dhcpRelayRequest{
+		Relay: dhcpRelayBody{
+			Name:            tfRelay.Name,
+			ServerAddresses: tfRelay.ServerAddresses,
+		},
+	}
+}
@@ -5,11 +5,11 @@ package cmp
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
 	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tshihad/tftags"
 )
 
@@ -34,10 +34,28 @@ func (dhcp *dhcpServer) Read(ctx context.Context, d *utils.Data, meta interface{
 	getdhcpServerResp, err := dhcp.dhcpClient.GetSpecificDhcpServer(ctx, dhcpServerResp.NetworkServerID,
 		dhcpServerResp.ID)
 	if err != nil {
+		return handleNotFound(d, err, "DHCP server")
+	}
+
+	if err := tftags.Set(d, getdhcpServerResp.GetSpecificNetworkDhcpServerResp); err != nil {
 		return err
 	}
 
-	return tftags.Set(d, getdhcpServerResp.GetSpecificNetworkDhcpServerResp)
+	// populate configurable fields from the response, so that imported DHCP
+	// server and changes made outside terraform will show up on plan
+	server := getdhcpServerResp.GetSpecificNetworkDhcpServerResp
+
+	return setState(d, map[string]interface{}{
+		"name":              server.Name,
+		"lease_time":        server.LeaseTime,
+		"server_address":    server.ServerIPAddress,
+		"network_server_id": server.NetworkServerID.ID,
+	})
+}
+
+// Import DHCP server with the ID in the format '<network_server_id>/<dhcp_server_id>'
+func (dhcp *dhcpServer) Import(ctx context.Context, d *utils.Data, meta interface{}) error {
+	return importChild(ctx, d, meta, "network_server_id", dhcp)
 }
 
 func (dhcp *dhcpServer) Update(ctx context.Context, d *utils.Data, meta interface{}) error {
@@ -48,8 +66,10 @@ func (dhcp *dhcpServer) Update(ctx context.Context, d *utils.Data, meta interfac
 	}
 
 	retry := &utils.CustomRetry{
-		InitialDelay: time.Second * 15,
-		RetryDelay:   time.Second * 30,
+		InitialDelay: retryInitialDelay,
+		RetryDelay:   retryDelay,
+		Timeout:      d.Timeout(schema.TimeoutUpdate),
+		Cond:         utils.ErrorCond(maxErrCount, utils.AnyResponse),
 	}
 	_, err := retry.Retry(ctx, meta, func(ctx context.Context) (interface{}, error) {
 		return dhcp.dhcpClient.UpdateDhcpServer(ctx,
@@ -86,8 +106,9 @@ func (dhcp *dhcpServer) Create(ctx context.Context, d *utils.Data, meta interfac
 
 	// wait until created
 	retry := &utils.CustomRetry{
-		InitialDelay: time.Second * 15,
-		RetryDelay:   time.Second * 30,
+		InitialDelay: retryInitialDelay,
+		RetryDelay:   retryDelay,
+		Timeout:      d.Timeout(schema.TimeoutCreate),
 	}
 	_, err = retry.Retry(ctx, meta, func(ctx context.Context) (interface{}, error) {
 		return dhcp.dhcpClient.GetSpecificDhcpServer(ctx,
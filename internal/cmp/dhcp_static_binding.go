@@ -0,0 +1,160 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package cmp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/tshihad/tftags"
+)
+
+// tfDhcpStaticBinding is the terraform model for hpegl_vmaas_dhcp_static_binding
+type tfDhcpStaticBinding struct {
+	ID             int                  `tf:"id,computed"`
+	NetworkID      int                  `tf:"network_id"`
+	Name           string               `tf:"name"`
+	Description    string               `tf:"description"`
+	MacAddress     string               `tf:"mac_address"`
+	IPAddress      string               `tf:"ip_address"`
+	HostName       string               `tf:"hostname"`
+	LeaseTime      int                  `tf:"lease_time"`
+	GatewayAddress string               `tf:"gateway_address"`
+	Option121      []dhcpClasslessRoute `tf:"option121"`
+	OtherOptions   []dhcpGenericOption  `tf:"other_option"`
+	ProviderID     string               `tf:"provider_id,computed"`
+}
+
+// dhcpStaticBinding implements functions related to static MAC to IP bindings
+// of the DHCP server of a segment
+type dhcpStaticBinding struct {
+	api *apiService
+}
+
+func newDhcpStaticBinding(api *apiService) *dhcpStaticBinding {
+	return &dhcpStaticBinding{
+		api: api,
+	}
+}
+
+func (s *dhcpStaticBinding) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
+	s.api.setMeta(meta)
+	var tfBinding tfDhcpStaticBinding
+	if err := tftags.Get(d, &tfBinding); err != nil {
+		return err
+	}
+
+	resp, err := s.api.GetDhcpStaticBinding(ctx, tfBinding.NetworkID, tfBinding.ID)
+	if err != nil {
+		return handleNotFound(d, err, "DHCP static binding")
+	}
+	binding := resp.StaticBinding
+
+	option121 := make([]map[string]interface{}, 0, len(binding.Config.Option121))
+	for _, r := range binding.Config.Option121 {
+		option121 = append(option121, map[string]interface{}{
+			"network":  r.Network,
+			"next_hop": r.NextHop,
+		})
+	}
+	otherOptions := make([]map[string]interface{}, 0, len(binding.Config.OtherOptions))
+	for _, o := range binding.Config.OtherOptions {
+		otherOptions = append(otherOptions, map[string]interface{}{
+			"code":   o.Code,
+			"values": o.Values,
+		})
+	}
+
+	return setState(d, map[string]interface{}{
+		"name":            binding.Name,
+		"description":     binding.Description,
+		"mac_address":     binding.MacAddress,
+		"ip_address":      binding.IPAddress,
+		"hostname":        binding.Config.HostName,
+		"lease_time":      binding.Config.LeaseTime,
+		"gateway_address": binding.Config.GatewayAddress,
+		"option121":       option121,
+		"other_option":    otherOptions,
+		"provider_id":     binding.ExternalID,
+	})
+}
+
+// Import DHCP static binding with the ID in the format '<network_id>/<binding_id>'
+func (s *dhcpStaticBinding) Import(ctx context.Context, d *utils.Data, meta interface{}) error {
+	return importChild(ctx, d, meta, "network_id", s)
+}
+
+func (s *dhcpStaticBinding) Create(ctx context.Context, d *utils.Data, meta interface{}) error {
+	s.api.setMeta(meta)
+	var tfBinding tfDhcpStaticBinding
+	if err := tftags.Get(d, &tfBinding); err != nil {
+		return err
+	}
+
+	resp, err := s.api.CreateDhcpStaticBinding(ctx, tfBinding.NetworkID, dhcpStaticBindingToRequest(tfBinding))
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "creating DHCP static binding for the network")
+	}
+	tfBinding.ID = resp.ID
+
+	return tftags.Set(d, tfBinding)
+}
+
+func (s *dhcpStaticBinding) Update(ctx context.Context, d *utils.Data, meta interface{}) error {
+	s.api.setMeta(meta)
+	var tfBinding tfDhcpStaticBinding
+	if err := tftags.Get(d, &tfBinding); err != nil {
+		return err
+	}
+
+	resp, err := s.api.UpdateDhcpStaticBinding(ctx, tfBinding.NetworkID, tfBinding.ID,
+		dhcpStaticBindingToRequest(tfBinding))
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "updating DHCP static binding for the network")
+	}
+
+	return nil
+}
+
+func (s *dhcpStaticBinding) Delete(ctx context.Context, d *utils.Data, meta interface{}) error {
+	s.api.setMeta(meta)
+	var tfBinding tfDhcpStaticBinding
+	if err := tftags.Get(d, &tfBinding); err != nil {
+		return err
+	}
+
+	resp, err := s.api.DeleteDhcpStaticBinding(ctx, tfBinding.NetworkID, tfBinding.ID)
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "deleting DHCP static binding for the network")
+	}
+
+	return nil
+}
+
+func dhcpStaticBindingToRequest(tfBinding tfDhcpStaticBinding) dhcpStaticBindingRequest {
+	return dhcpStaticBindingRequest{
+		StaticBinding: dhcpStaticBindingBody{
+			Name:        tfBinding.Name,
+			Description: tfBinding.Description,
+			MacAddress:  tfBinding.MacAddress,
+			IPAddress:   tfBinding.IPAddress,
+			Config: dhcpStaticBindingConfig{
+				HostName:       tfBinding.HostName,
+				LeaseTime:      tfBinding.LeaseTime,
+				GatewayAddress: tfBinding.GatewayAddress,
+				Option121:      tfBinding.Option121,
+				OtherOptions:   tfBinding.OtherOptions,
+			},
+		},
+	}
+}
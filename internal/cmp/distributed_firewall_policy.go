@@ -0,0 +1,215 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package cmp
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/tshihad/tftags"
+)
+
+// tfDfwPolicy is the terraform model for hpegl_vmaas_distributed_firewall_policy
+type tfDfwPolicy struct {
+	ID              int         `tf:"id,computed"`
+	NetworkServerID int         `tf:"network_server_id,computed"`
+	Name            string      `tf:"name"`
+	Description     string      `tf:"description"`
+	Category        string      `tf:"category"`
+	Priority        int         `tf:"priority"`
+	Stateful        bool        `tf:"stateful"`
+	Rules           []tfDfwRule `tf:"rule"`
+	ExternalID      string      `tf:"external_id,computed"`
+}
+
+// tfDfwRule is a rule of the distributed firewall policy. Order of the rules
+// in the configuration is the order of evaluation
+type tfDfwRule struct {
+	Name         string   `tf:"name"`
+	Description  string   `tf:"description"`
+	Enabled      bool     `tf:"enabled"`
+	Action       string   `tf:"action"`
+	Direction    string   `tf:"direction"`
+	Sources      []string `tf:"sources"`
+	Destinations []string `tf:"destinations"`
+	Services     []string `tf:"services"`
+	AppliedTo    []string `tf:"applied_to"`
+	Logging      bool     `tf:"logging"`
+}
+
+// distributedFirewallPolicy implements functions related to distributed firewall
+// (east-west) policies of the NSX network server
+type distributedFirewallPolicy struct {
+	rClient *client.RouterAPIService
+	api     *apiService
+}
+
+func newDistributedFirewallPolicy(routerClient *client.RouterAPIService, api *apiService) *distributedFirewallPolicy {
+	return &distributedFirewallPolicy{
+		rClient: routerClient,
+		api:     api,
+	}
+}
+
+func (p *distributedFirewallPolicy) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
+	setMeta(meta, p.rClient.Client)
+	p.api.setMeta(meta)
+	var tfPolicy tfDfwPolicy
+	if err := tftags.Get(d, &tfPolicy); err != nil {
+		return err
+	}
+
+	if tfPolicy.NetworkServerID == 0 {
+		serverID, err := getNsxNetworkServerID(ctx, p.rClient)
+		if err != nil {
+			return err
+		}
+		tfPolicy.NetworkServerID = serverID
+	}
+	resp, err := p.api.GetDfwPolicy(ctx, tfPolicy.NetworkServerID, tfPolicy.ID)
+	if err != nil {
+		return handleNotFound(d, err, "Distributed firewall policy")
+	}
+	policy := resp.RuleGroup
+
+	return setState(d, map[string]interface{}{
+		"network_server_id": tfPolicy.NetworkServerID,
+		"name":              policy.Name,
+		"description":       policy.Description,
+		"category":          policy.Config.Category,
+		"priority":          policy.Priority,
+		"stateful":          policy.Config.Stateful,
+		"rule":              dfwRulesToList(policy.Rules),
+		"external_id":       policy.ExternalID,
+	})
+}
+
+// Import distributed firewall policy with the ID in the format '<network_server_id>/<policy_id>'
+func (p *distributedFirewallPolicy) Import(ctx context.Context, d *utils.Data, meta interface{}) error {
+	return importChild(ctx, d, meta, "network_server_id", p)
+}
+
+func (p *distributedFirewallPolicy) Create(ctx context.Context, d *utils.Data, meta interface{}) error {
+	setMeta(meta, p.rClient.Client)
+	p.api.setMeta(meta)
+	var tfPolicy tfDfwPolicy
+	if err := tftags.Get(d, &tfPolicy); err != nil {
+		return err
+	}
+
+	serverID, err := getNsxNetworkServerID(ctx, p.rClient)
+	if err != nil {
+		return err
+	}
+	resp, err := p.api.CreateDfwPolicy(ctx, serverID, dfwPolicyToRequest(tfPolicy))
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "creating distributed firewall policy")
+	}
+	tfPolicy.ID = resp.ID
+	tfPolicy.NetworkServerID = serverID
+
+	return tftags.Set(d, tfPolicy)
+}
+
+func (p *distributedFirewallPolicy) Update(ctx context.Context, d *utils.Data, meta interface{}) error {
+	p.api.setMeta(meta)
+	var tfPolicy tfDfwPolicy
+	if err := tftags.Get(d, &tfPolicy); err != nil {
+		return err
+	}
+
+	resp, err := p.api.UpdateDfwPolicy(ctx, tfPolicy.NetworkServerID, tfPolicy.ID, dfwPolicyToRequest(tfPolicy))
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "updating distributed firewall policy")
+	}
+
+	return nil
+}
+
+func (p *distributedFirewallPolicy) Delete(ctx context.Context, d *utils.Data, meta interface{}) error {
+	p.api.setMeta(meta)
+	var tfPolicy tfDfwPolicy
+	if err := tftags.Get(d, &tfPolicy); err != nil {
+		return err
+	}
+
+	resp, err := p.api.DeleteDfwPolicy(ctx, tfPolicy.NetworkServerID, tfPolicy.ID)
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "deleting distributed firewall policy")
+	}
+
+	return nil
+}
+
+func dfwPolicyToRequest(tfPolicy tfDfwPolicy) dfwPolicyRequest {
+	rules := make([]dfwRuleBody, 0, len(tfPolicy.Rules))
+	for i, rule := range tfPolicy.Rules {
+		rules = append(rules, dfwRuleBody{
+			Name:           rule.Name,
+			Description:    rule.Description,
+			Enabled:        rule.Enabled,
+			SequenceNumber: i + 1,
+			Action:         rule.Action,
+			Direction:      rule.Direction,
+			Config: routerFirewallRuleConfig{
+				Sources:      rule.Sources,
+				Destinations: rule.Destinations,
+				Services:     rule.Services,
+				Scope:        rule.AppliedTo,
+				Logging:      rule.Logging,
+			},
+		})
+	}
+
+	return dfwPolicyRequest{
+		RuleGroup: dfwPolicyBody{
+			Name:         tfPolicy.Name,
+			Description:  tfPolicy.Description,
+			Priority:     tfPolicy.Priority,
+			ExternalType: dfwExternalPolicy,
+			Config: dfwPolicyConfig{
+				Category: tfPolicy.Category,
+				Stateful: tfPolicy.Stateful,
+			},
+			Rules: rules,
+		},
+	}
+}
+
+func dfwRulesToList(rules []dfwRuleBody) []map[string]interface{} {
+	// rules are evaluated in the order of sequence number, so that the list
+	// follows the same order irrespective of the order in the response
+	sort.SliceStable(rules, func(i, j int) bool {
+		return rules[i].SequenceNumber < rules[j].SequenceNumber
+	})
+
+	list := make([]map[string]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		list = append(list, map[string]interface{}{
+			"name":         rule.Name,
+			"description":  rule.Description,
+			"enabled":      rule.Enabled,
+			"action":       rule.Action,
+			"direction":    rule.Direction,
+			"sources":      rule.Config.Sources,
+			"destinations": rule.Config.Destinations,
+			"services":     rule.Config.Services,
+			"applied_to":   rule.Config.Scope,
+			"logging":      rule.Config.Logging,
+		})
+	}
+
+	return list
+}
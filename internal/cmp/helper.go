@@ -4,12 +4,16 @@ package cmp
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"net/http"
 	"strconv"
 	"strings"
 
 	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/auth"
+	pkgUtils "github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/utils"
 )
 
 func setMeta(meta interface{}, apiClient client.APIClientHandler) {
@@ -19,6 +23,62 @@ func setMeta(meta interface{}, apiClient client.APIClientHandler) {
 	}
 }
 
+// handleNotFound removes the resource from the state if err is a not found error,
+// so that terraform will plan to recreate the resource which is deleted outside
+// terraform. Returns err as it is for any other error.
+func handleNotFound(d *utils.Data, err error, resource string) error {
+	if pkgUtils.GetStatusCode(err) != http.StatusNotFound {
+		return err
+	}
+	log.Printf("[WARN] %s %s is not found, removing from state", resource, d.Id())
+	d.SetID("")
+
+	return nil
+}
+
+// setState sets values on the state. Unlike tftags.Set, non computed attributes
+// and empty values are also set, so that changes made outside terraform will
+// show up on plan.
+func setState(d *utils.Data, values map[string]interface{}) error {
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// importChild imports a resource which belongs to a parent object, such as a
+// NAT rule of a router, with the ID in the format '<parent_id>/<id>'. Parent ID
+// is set on parentKey and the state is populated by reading the resource.
+func importChild(ctx context.Context, d *utils.Data, meta interface{}, parentKey string, r DataSource) error {
+	ids := strings.Split(d.GetIDString(), "/")
+	if len(ids) != 2 {
+		return fmt.Errorf("invalid import ID %q, expected '<%s>/<id>'", d.GetIDString(), parentKey)
+	}
+	parentID, err := strconv.Atoi(ids[0])
+	if err != nil {
+		return fmt.Errorf("invalid %s %q", parentKey, ids[0])
+	}
+	if _, err := strconv.Atoi(ids[1]); err != nil {
+		return fmt.Errorf("invalid ID %q", ids[1])
+	}
+	if err := d.Set(parentKey, parentID); err != nil {
+		return err
+	}
+	d.SetID(ids[1])
+
+	if err := r.Read(ctx, d, meta); err != nil {
+		return err
+	}
+	if d.Id() == "" {
+		return fmt.Errorf("resource %s is not found for the %s %d", ids[1], parentKey, parentID)
+	}
+
+	return nil
+}
+
 func ParseVersion(version string) (int, error) {
 	if version == "" {
 		return 0, nil
@@ -66,3 +126,23 @@ func GetNsxTypeFromCMP(ctx context.Context, apiClient client.APIClientHandler) (
 
 	return nsxt, nil
 }
+
+// getNsxNetworkServerID returns the ID of the NSX network server, which owns
+// the objects that are not specific to a router, such as IPsec VPN and groups
+func getNsxNetworkServerID(ctx context.Context, rClient *client.RouterAPIService) (int, error) {
+	nsxType, err := GetNsxTypeFromCMP(ctx, rClient.Client)
+	if err != nil {
+		return 0, err
+	}
+	nsResp, err := rClient.GetNetworkServices(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	for _, n := range nsResp.NetworkServices {
+		if n.TypeName == nsxType {
+			return n.ID, nil
+		}
+	}
+
+	return 0, fmt.Errorf(errExactMatch, "network server")
+}
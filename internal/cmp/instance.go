@@ -4,12 +4,14 @@ package cmp
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"strings"
 
 	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
 	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // instance implements functions related to cmp instances
@@ -18,11 +20,12 @@ type instance struct {
 	instanceSharedClient
 }
 
-func newInstance(iClient *client.InstancesAPIService, sClient *client.ServersAPIService) *instance {
+func newInstance(iClient *client.InstancesAPIService, sClient *client.ServersAPIService, api *apiService) *instance {
 	return &instance{
 		instanceSharedClient{
 			iClient: iClient,
 			sClient: sClient,
+			api:     api,
 		},
 	}
 }
@@ -57,26 +60,42 @@ func (i *instance) Create(ctx context.Context, d *utils.Data, meta interface{})
 		Evars:             instanceGetEvars(d.GetMap("evars")),
 		Labels:            d.GetStringList("labels"),
 		Volumes:           instanceGetVolume(d.GetListMap("volume")),
-		NetworkInterfaces: instanceGetNetwork(d.GetListMap("network")),
-		Config:            instanceGetConfig(c, strings.ToLower(d.GetString("instance_type_code")) == vmware),
 		Tags:              instanceGetTags(d.GetMap("tags")),
 		LayoutSize:        d.GetInt("scale"),
 		PowerScheduleType: d.GetJSONNumber("power_schedule_id"),
 	}
+	networks := instanceGetNetwork(d.GetListMap("network"))
+	config := instanceGetConfig(c, strings.ToLower(d.GetString("instance_type_code")) == vmware)
 
 	// Pre check
 	if err := d.Error(); err != nil {
 		return err
 	}
 
+	// Resource pool ID is prefixed in the same way as cmp-sdk, since the instance is
+	// created with the api service to include the addressing and guest customization
+	cmpVersion, err := GetCmpVersion(ctx, i.iClient.Client)
+	if err != nil {
+		return err
+	}
+	if v, _ := ParseVersion("6.0.3"); v <= cmpVersion && !utils.IsEmpty(config.ResourcePoolID) {
+		config.ResourcePoolID = fmt.Sprintf("pool-%v", config.ResourcePoolID)
+	}
+
 	// create instance
-	respVM, err := i.iClient.CreateAnInstance(ctx, req)
+	i.api.setMeta(meta)
+	respVM, err := i.api.CreateInstance(ctx, createInstanceRequest{
+		CreateInstanceBody: req,
+		NetworkInterfaces:  networks,
+		Config:             config,
+	})
 	if err != nil {
 		return err
 	}
 	getInstanceBody := *respVM.Instance
 
-	if err := instanceWaitUntilCreated(ctx, i.instanceSharedClient, meta, getInstanceBody.ID); err != nil {
+	err = instanceWaitUntilCreated(ctx, i.instanceSharedClient, meta, getInstanceBody.ID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
 		return err
 	}
 
@@ -105,7 +124,7 @@ func (i *instance) Create(ctx context.Context, d *utils.Data, meta interface{})
 func (i *instance) Update(ctx context.Context, d *utils.Data, meta interface{}) error {
 	setMeta(meta, i.iClient.Client)
 
-	return updateInstance(ctx, i.instanceSharedClient, d)
+	return updateInstance(ctx, i.instanceSharedClient, d, meta)
 }
 
 func (i *instance) Delete(ctx context.Context, d *utils.Data, meta interface{}) error {
@@ -119,3 +138,9 @@ func (i *instance) Read(ctx context.Context, d *utils.Data, meta interface{}) er
 
 	return readInstance(ctx, i.instanceSharedClient, d, meta, false)
 }
+
+func (i *instance) Import(ctx context.Context, d *utils.Data, meta interface{}) error {
+	setMeta(meta, i.iClient.Client)
+
+	return importInstance(ctx, i.instanceSharedClient, d, meta, false)
+}
@@ -4,16 +4,17 @@ package cmp
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
 	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 const (
@@ -26,11 +27,16 @@ type instanceClone struct {
 	instanceSharedClient
 }
 
-func newInstanceClone(iClient *client.InstancesAPIService, sClient *client.ServersAPIService) *instanceClone {
+func newInstanceClone(
+	iClient *client.InstancesAPIService,
+	sClient *client.ServersAPIService,
+	api *apiService,
+) *instanceClone {
 	return &instanceClone{
 		instanceSharedClient: instanceSharedClient{
 			iClient: iClient,
 			sClient: sClient,
+			api:     api,
 		},
 	}
 }
@@ -53,16 +59,18 @@ func (i *instanceClone) Create(ctx context.Context, d *utils.Data, meta interfac
 			InstanceContext:   d.GetString("environment_code"),
 			PowerScheduleType: d.GetJSONNumber("power_schedule_id"),
 		},
-		Plan:              models.IDModel{ID: d.GetInt("plan_id")},
-		LayoutSize:        d.GetInt("scale"),
-		NetworkInterfaces: instanceGetNetwork(d.GetListMap("network")),
-		Evars:             instanceGetEvars(d.GetMap("evars")),
-		Metadata:          instanceGetTags(d.GetMap("tags")),
+		Plan:       models.IDModel{ID: d.GetInt("plan_id")},
+		LayoutSize: d.GetInt("scale"),
+		Evars:      instanceGetEvars(d.GetMap("evars")),
+		Metadata:   instanceGetTags(d.GetMap("tags")),
 	}
+	networks := instanceGetNetwork(d.GetListMap("network"))
 
+	config := &instanceConfig{}
 	c := d.GetListMap("config")
 	if len(c) > 0 {
-		req.Config = *instanceGetConfig(c[0], strings.ToLower(req.InstanceType.Code) == vmware)
+		config = instanceGetConfig(c[0], strings.ToLower(req.InstanceType.Code) == vmware)
+		req.Config = config.CreateInstanceBodyConfig
 	}
 	// Pre check
 	if err := d.Error(); err != nil {
@@ -78,13 +86,19 @@ func (i *instanceClone) Create(ctx context.Context, d *utils.Data, meta interfac
 
 	// clone the instance
 	log.Printf("[INFO] Cloning the instance with %d", sourceID)
-	err = cloneInstance(ctx, i, meta, req, sourceID)
+	// config of the request is updated with the source instance
+	config.CreateInstanceBodyConfig = req.Config
+	err = cloneInstance(ctx, i, meta, cloneInstanceRequest{
+		CreateInstanceCloneBody: req,
+		NetworkInterfaces:       networks,
+		Config:                  *config,
+	}, sourceID)
 	if err != nil {
 		return err
 	}
 
 	log.Printf("[INFO] Check history")
-	err = checkInstanceCloneHistory(ctx, i, meta, sourceID)
+	err = checkInstanceCloneHistory(ctx, i, meta, sourceID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
@@ -92,7 +106,7 @@ func (i *instanceClone) Create(ctx context.Context, d *utils.Data, meta interfac
 	log.Printf("[INFO] Get all instances")
 	getInstanceRetry := &utils.CustomRetry{
 		RetryDelay: instanceCloneRetryDelay,
-		Timeout:    time.Minute * 2,
+		Timeout:    d.Timeout(schema.TimeoutCreate),
 		Cond: func(resp interface{}, err error) (bool, error) {
 			if err != nil {
 				return false, nil
@@ -117,7 +131,9 @@ func (i *instanceClone) Create(ctx context.Context, d *utils.Data, meta interfac
 		return errors.New("get cloned instance is failed")
 	}
 
-	if err := instanceWaitUntilCreated(ctx, i.instanceSharedClient, meta, instancesList.Instances[0].ID); err != nil {
+	err = instanceWaitUntilCreated(ctx, i.instanceSharedClient, meta, instancesList.Instances[0].ID,
+		d.Timeout(schema.TimeoutCreate))
+	if err != nil {
 		return err
 	}
 
@@ -148,7 +164,7 @@ func (i *instanceClone) Create(ctx context.Context, d *utils.Data, meta interfac
 func (i *instanceClone) Update(ctx context.Context, d *utils.Data, meta interface{}) error {
 	setMeta(meta, i.iClient.Client)
 
-	return updateInstance(ctx, i.instanceSharedClient, d)
+	return updateInstance(ctx, i.instanceSharedClient, d, meta)
 }
 
 // Delete instance and set ID as ""
@@ -165,23 +181,42 @@ func (i *instanceClone) Read(ctx context.Context, d *utils.Data, meta interface{
 	return readInstance(ctx, i.instanceSharedClient, d, meta, true)
 }
 
-func checkInstanceCloneHistory(ctx context.Context, i *instanceClone, meta interface{}, instanceID int) error {
-	errCount := 0
-	historyRetry := utils.CustomRetry{
-		InitialDelay: time.Second * 15,
-		RetryDelay:   time.Second * 30,
-		Timeout:      maxTimeout,
-		Cond: func(response interface{}, ResponseErr error) (bool, error) {
-			if ResponseErr != nil {
-				errCount++
-				if errCount == 3 {
-					return false, ResponseErr
-				}
+// Import cloned instance. Source instance is not exposed by the API, so
+// import ID can either be '<instance_id>' or '<instance_id>/<source_instance_id>'
+func (i *instanceClone) Import(ctx context.Context, d *utils.Data, meta interface{}) error {
+	setMeta(meta, i.iClient.Client)
 
-				return false, nil
-			}
-			errCount = 0
+	ids := strings.Split(d.GetIDString(), "/")
+	if len(ids) > 2 {
+		return fmt.Errorf("invalid import ID %q, expected '<instance_id>' or "+
+			"'<instance_id>/<source_instance_id>'", d.GetIDString())
+	}
+	if len(ids) == 2 {
+		sourceID, err := strconv.Atoi(ids[1])
+		if err != nil {
+			return fmt.Errorf("invalid source instance ID %q", ids[1])
+		}
+		if err := d.Set("source_instance_id", sourceID); err != nil {
+			return err
+		}
+		d.SetID(ids[0])
+	}
+
+	return importInstance(ctx, i.instanceSharedClient, d, meta, true)
+}
 
+func checkInstanceCloneHistory(
+	ctx context.Context,
+	i *instanceClone,
+	meta interface{},
+	instanceID int,
+	timeout time.Duration,
+) error {
+	historyRetry := utils.CustomRetry{
+		InitialDelay: retryInitialDelay,
+		Backoff:      utils.DecorrelatedJitterBackoff(retryInitialDelay, maxRetryDelay),
+		Timeout:      timeout,
+		Cond: utils.PollErrorCond(maxErrCount, func(response interface{}) (bool, error) {
 			instanceHistory := response.(models.GetInstanceHistory)
 			for _, processes := range instanceHistory.Processes {
 				if processes.ProcessType.Code == "cloning" {
@@ -197,7 +232,7 @@ func checkInstanceCloneHistory(ctx context.Context, i *instanceClone, meta inter
 			}
 
 			return false, nil
-		},
+		}),
 	}
 	_, err := historyRetry.Retry(ctx, meta, func(ctx context.Context) (interface{}, error) {
 		return i.iClient.GetInstanceHistory(ctx, instanceID)
@@ -210,9 +245,23 @@ func cloneInstance(
 	ctx context.Context,
 	i *instanceClone,
 	meta interface{},
-	req models.CreateInstanceCloneBody,
+	req cloneInstanceRequest,
 	sourceID int,
 ) error {
+	// Tags and labels are moved in the same way as cmp-sdk, since the instance is
+	// cloned with the api service to include the addressing and guest customization
+	cmpVersion, err := GetCmpVersion(ctx, i.iClient.Client)
+	if err != nil {
+		return err
+	}
+	if v, _ := ParseVersion("5.2.12"); v <= cmpVersion {
+		req.Tags = req.Metadata
+		req.Metadata = nil
+		req.Instance.Labels = req.Instance.Tags
+		req.Instance.Tags = nil
+	}
+
+	i.api.setMeta(meta)
 	cloneRetry := &utils.CustomRetry{
 		Cond: func(response interface{}, ResponseErr error) (bool, error) {
 			if ResponseErr != nil {
@@ -225,14 +274,8 @@ func cloneInstance(
 			return true, nil
 		},
 	}
-	_, err := cloneRetry.Retry(ctx, meta, func(ctx context.Context) (interface{}, error) {
-		val, err := json.Marshal(&req)
-		if err != nil {
-			return nil, err
-		}
-		log.Printf("value: %s", string(val))
-
-		return i.iClient.CloneAnInstance(ctx, sourceID, req)
+	_, err = cloneRetry.Retry(ctx, meta, func(ctx context.Context) (interface{}, error) {
+		return i.api.CloneInstance(ctx, sourceID, req)
 	})
 
 	return err
@@ -8,18 +8,37 @@ import (
 	"log"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
 	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
 	pkgUtils "github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tshihad/tftags"
 )
 
 type instanceSharedClient struct {
 	iClient *client.InstancesAPIService
 	sClient *client.ServersAPIService
+	api     *apiService
+}
+
+// tfInstanceNetworks holds the network of the instance along with the addressing
+// of the interfaces, which is not part of the instance model of cmp-sdk
+type tfInstanceNetworks struct {
+	Network []tfInstanceNetwork `tf:"network,computed"`
+}
+
+type tfInstanceNetwork struct {
+	ID          int    `tf:"id"`
+	InterfaceID int    `tf:"interface_id"`
+	IPMode      string `tf:"ip_mode"`
+	IPAddress   string `tf:"ip_address"`
+	IsPrimary   bool   `tf:"is_primary"`
+	InternalID  int    `tf:"internal_id"`
+	Name        string `tf:"name"`
 }
 
 func readInstance(ctx context.Context, sharedClient instanceSharedClient, d *utils.Data, meta interface{}, isClone bool) error {
@@ -33,25 +52,21 @@ func readInstance(ctx context.Context, sharedClient instanceSharedClient, d *uti
 
 	instance, err := sharedClient.iClient.GetASpecificInstance(ctx, id)
 	if err != nil {
-		return err
+		return handleNotFound(d, err, "Instance")
 	}
 
 	tfInstance := models.TFInstance{}
 	if err := tftags.Get(d, &tfInstance); err != nil {
 		return err
 	}
-
-	// Assign proper ID for the volume, since response may contains more
-	// volumes than schema, check the name and assign id
-	for i := range tfInstance.Volume {
-		for _, vModel := range instance.Instance.Volumes {
-			if vModel.Name == tfInstance.Volume[i].Name {
-				tfInstance.Volume[i].ID = vModel.ID
-
-				break
-			}
-		}
+	tfNetworks := tfInstanceNetworks{}
+	if err := tftags.Get(d, &tfNetworks); err != nil {
+		return err
 	}
+
+	// Rebuild volumes from the response, so that any changes done outside
+	// terraform will be reflected on the plan
+	tfInstance.Volume = instanceGetVolumeModel(tfInstance.Volume, instance.Instance.Volumes, isClone)
 	// Invoke all API request in parallel
 	// Get server details
 	serverRetry := &utils.CustomRetry{}
@@ -76,7 +91,7 @@ func readInstance(ctx context.Context, sharedClient instanceSharedClient, d *uti
 		}
 	}
 
-	tfInstance.Network, err = instanceGetNetworkModel(tfInstance.Network, serverRetry)
+	tfNetworks.Network, err = instanceGetNetworkModel(tfNetworks.Network, instance.Instance.Interfaces, serverRetry)
 	if err != nil {
 		return err
 	}
@@ -93,6 +108,14 @@ func readInstance(ctx context.Context, sharedClient instanceSharedClient, d *uti
 	if err != nil {
 		return err
 	}
+	// network is set after the instance model, so that the addressing is retained
+	err = tftags.Set(d, tfNetworks)
+	if err != nil {
+		return err
+	}
+	if err := instanceSetAttributes(d, instance.Instance); err != nil {
+		return err
+	}
 
 	d.SetID(instance.Instance.ID)
 
@@ -100,11 +123,152 @@ func readInstance(ctx context.Context, sharedClient instanceSharedClient, d *uti
 	return d.Error()
 }
 
+// importInstance rebuilds the terraform state of an existing instance using
+// the instance and server APIs and then reads the computed attributes.
+func importInstance(
+	ctx context.Context,
+	sharedClient instanceSharedClient,
+	d *utils.Data,
+	meta interface{},
+	isClone bool,
+) error {
+	id := d.GetID()
+
+	log.Printf("[INFO] Importing instance with ID %d", id)
+	// Precheck
+	if err := d.Error(); err != nil {
+		return err
+	}
+
+	resp, err := sharedClient.iClient.GetASpecificInstance(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to import instance with ID %d: %w", id, err)
+	}
+	instance := resp.Instance
+	if instance == nil {
+		return fmt.Errorf("failed to import instance with ID %d, got empty response", id)
+	}
+
+	d.SetString("env_prefix", instance.EnvironmentPrefix)
+	d.SetString("hostname", instance.HostName)
+	d.SetString("power", utils.ParsePowerState(instance.Status))
+	state := map[string]interface{}{
+		"volume": instanceImportVolumes(instance.Volumes),
+	}
+	if instance.Cloud != nil {
+		state["cloud_id"] = instance.Cloud.ID
+	}
+	if instance.Layout != nil {
+		state["layout_id"] = instance.Layout.ID
+	}
+	if instance.InstanceType != nil {
+		d.SetString("instance_type_code", instance.InstanceType.Code)
+	}
+	if instance.Config != nil {
+		state["config"] = []map[string]interface{}{instanceImportConfig(instance.Config)}
+		if powerSchedule, err := instance.Config.PowerScheduleType.Int64(); err == nil {
+			state["power_schedule_id"] = int(powerSchedule)
+		}
+	}
+	if err := setState(d, state); err != nil {
+		return err
+	}
+
+	// name is required to get the server ID
+	d.SetString("name", instance.Name)
+	if err := instanceSetServerID(ctx, d, sharedClient); err != nil {
+		return err
+	}
+
+	if err := d.Error(); err != nil {
+		return err
+	}
+
+	// network, labels and rest of the attributes will be set from read
+	return readInstance(ctx, sharedClient, d, meta, isClone)
+}
+
+// instanceImportVolumes converts API volume response to schema volumes
+func instanceImportVolumes(vModels []models.GetInstanceResponseInstanceVolumes) []map[string]interface{} {
+	volumes := make([]map[string]interface{}, 0, len(vModels))
+	for _, v := range vModels {
+		volume := instanceImportVolume(v)
+		volumes = append(volumes, map[string]interface{}{
+			"id":           volume.ID,
+			"name":         volume.Name,
+			"size":         volume.Size,
+			"datastore_id": volume.DatastoreID,
+			"root":         volume.Root,
+		})
+	}
+
+	return volumes
+}
+
+func instanceImportVolume(v models.GetInstanceResponseInstanceVolumes) models.TFInstanceVolume {
+	datastoreID := "auto"
+	if v.DatastoreID != nil {
+		datastoreID = fmt.Sprint(v.DatastoreID)
+	}
+
+	return models.TFInstanceVolume{
+		ID:          v.ID,
+		Name:        v.Name,
+		Size:        v.Size,
+		DatastoreID: datastoreID,
+		Root:        v.RootVolume,
+	}
+}
+
+// instanceImportConfig converts config response to schema config
+func instanceImportConfig(c *models.GetInstanceResponseInstanceConfig) map[string]interface{} {
+	return map[string]interface{}{
+		"resource_pool_id": instanceParseResourcePoolID(c.ResourcePoolID),
+		"template_id":      c.Template,
+		"no_agent":         instanceParseBool(c.Noagent),
+		"folder_code":      c.Vmwarefolderid,
+		"asset_tag":        c.Smbiosassettag,
+		"create_user":      c.Createuser,
+	}
+}
+
+// instanceParseResourcePoolID parses resource pool ID. From CMP 6.0.3 onwards resource
+// pool ID is prefixed with 'pool-'
+func instanceParseResourcePoolID(poolID interface{}) int {
+	switch p := poolID.(type) {
+	case float64:
+		return int(p)
+	case string:
+		id, err := strconv.Atoi(strings.TrimPrefix(p, "pool-"))
+		if err != nil {
+			return 0
+		}
+
+		return id
+	}
+
+	return 0
+}
+
+func instanceParseBool(val interface{}) bool {
+	switch v := val.(type) {
+	case bool:
+		return v
+	case string:
+		b, _ := strconv.ParseBool(v)
+
+		return b || v == "on"
+	}
+
+	return false
+}
+
 // Update instance including poweroff, powerOn, restart, suspend
 // changing volumes and instance properties such as labels
 // groups and tags
-func updateInstance(ctx context.Context, sharedClient instanceSharedClient, d *utils.Data) error {
+func updateInstance(ctx context.Context, sharedClient instanceSharedClient, d *utils.Data, meta interface{}) error {
 	log.Printf("[DEBUG] Updating the instance")
+	sharedClient.api.setMeta(meta)
 
 	id := d.GetID()
 	if d.HasChanged("name") || d.HasChanged("group_id") || d.HasChanged("tags") ||
@@ -158,6 +322,14 @@ func updateInstance(ctx context.Context, sharedClient instanceSharedClient, d *u
 		}
 	}
 
+	if (d.HasChanged("revert_snapshot") || d.HasChanged("revert_trigger")) && d.GetString("revert_snapshot") != "" {
+		err := revertInstanceSnapshot(ctx, sharedClient, meta, id, d.GetString("revert_snapshot"),
+			d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
+		}
+	}
+
 	if d.HasChanged("snapshot") {
 		snapshot := d.GetListMap("snapshot")
 		err := createInstanceSnapshot(ctx, sharedClient, getInstance.Instance.ID, models.SnapshotBody{
@@ -192,27 +364,27 @@ func deleteInstance(ctx context.Context, sharedClient instanceSharedClient, d *u
 		return fmt.Errorf("failed to delete instance with error: %s", deleResp.Message)
 	}
 
-	errCount := 0
+	// wait until the instance is deleted
+	errCond := utils.ErrorCond(maxErrCount, func(response interface{}) (bool, error) {
+		return false, nil
+	})
 	cRetry := utils.CustomRetry{
-		RetryDelay: time.Second * 15,
-		Timeout:    maxTimeout,
+		Backoff: utils.ExponentialBackoff(time.Second*15, maxRetryDelay),
+		Timeout: d.Timeout(schema.TimeoutDelete),
 		Cond: func(response interface{}, ResponseErr error) (bool, error) {
-			if ResponseErr != nil {
-				if pkgUtils.GetStatusCode(ResponseErr) == http.StatusNotFound {
-					return true, nil
-				}
-				errCount++
-				if errCount == 3 {
-					return false, err
-				}
+			if pkgUtils.GetStatusCode(ResponseErr) == http.StatusNotFound {
+				return true, nil
 			}
 
-			return false, nil
+			return errCond(response, ResponseErr)
 		},
 	}
 	_, err = cRetry.Retry(ctx, meta, func(ctx context.Context) (interface{}, error) {
 		return sharedClient.iClient.GetASpecificInstance(ctx, id)
 	})
+	if err != nil {
+		return err
+	}
 
 	// post check
 	return d.Error()
@@ -248,28 +420,38 @@ func instanceResizeVolume(volumes []map[string]interface{}) []models.ResizeInsta
 	return volumesModel
 }
 
-func instanceGetNetwork(networksMap []map[string]interface{}) []models.CreateInstanceBodyNetworkInterfaces {
-	networks := make([]models.CreateInstanceBodyNetworkInterfaces, 0, len(networksMap))
+func instanceGetNetwork(networksMap []map[string]interface{}) []instanceNetworkInterface {
+	networks := make([]instanceNetworkInterface, 0, len(networksMap))
 	for _, n := range networksMap {
-		networks = append(networks, models.CreateInstanceBodyNetworkInterfaces{
-			Network: &models.CreateInstanceBodyNetwork{
-				ID: n["id"].(int),
+		networks = append(networks, instanceNetworkInterface{
+			CreateInstanceBodyNetworkInterfaces: models.CreateInstanceBodyNetworkInterfaces{
+				Network: &models.CreateInstanceBodyNetwork{
+					ID: n["id"].(int),
+				},
+				NetworkInterfaceTypeID: utils.JSONNumber(n["interface_id"]),
 			},
-			NetworkInterfaceTypeID: utils.JSONNumber(n["interface_id"]),
+			IPMode:    n["ip_mode"].(string),
+			IPAddress: n["ip_address"].(string),
 		})
 	}
 
 	return networks
 }
 
-func instanceGetConfig(c map[string]interface{}, isVmware bool) *models.CreateInstanceBodyConfig {
-	config := &models.CreateInstanceBodyConfig{
-		ResourcePoolID: c["resource_pool_id"],
-		NoAgent:        strconv.FormatBool(c["no_agent"].(bool)),
-		SmbiosAssetTag: c["asset_tag"].(string),
-		VMwareFolderID: c["folder_code"].(string),
-		Template:       c["template_id"].(int),
-		CreateUser:     c["create_user"].(bool),
+func instanceGetConfig(c map[string]interface{}, isVmware bool) *instanceConfig {
+	config := &instanceConfig{
+		CreateInstanceBodyConfig: models.CreateInstanceBodyConfig{
+			ResourcePoolID:   c["resource_pool_id"],
+			NoAgent:          strconv.FormatBool(c["no_agent"].(bool)),
+			SmbiosAssetTag:   c["asset_tag"].(string),
+			VMwareFolderID:   c["folder_code"].(string),
+			Template:         c["template_id"].(int),
+			CreateUser:       c["create_user"].(bool),
+			VmwareDomainName: c["domain_name"].(string),
+		},
+		UserData:      c["user_data"].(string),
+		SSHPublicKeys: instanceGetSSHPublicKeys(c["ssh_public_keys"]),
+		TaskSetID:     c["workflow_id"].(int),
 	}
 	if !isVmware {
 		config.Template = 0
@@ -278,6 +460,18 @@ func instanceGetConfig(c map[string]interface{}, isVmware bool) *models.CreateIn
 	return config
 }
 
+func instanceGetSSHPublicKeys(src interface{}) []string {
+	list, _ := src.([]interface{})
+	keys := make([]string, 0, len(list))
+	for _, k := range list {
+		if key, ok := k.(string); ok {
+			keys = append(keys, key)
+		}
+	}
+
+	return keys
+}
+
 func instanceGetTags(t map[string]interface{}) []models.CreateInstanceBodyTag {
 	tags := make([]models.CreateInstanceBodyTag, 0, len(t))
 	for k, v := range t {
@@ -435,6 +629,94 @@ func createInstanceSnapshot(
 	return nil
 }
 
+// revertInstanceSnapshot reverts the instance to the snapshot with the name and
+// waits until the revert process is completed
+func revertInstanceSnapshot(
+	ctx context.Context,
+	sharedClient instanceSharedClient,
+	meta interface{},
+	instanceID int,
+	name string,
+	timeout time.Duration,
+) error {
+	sharedClient.api.setMeta(meta)
+	log.Printf("[INFO] Reverting instance %d to the snapshot %s", instanceID, name)
+
+	snapshotResp, err := sharedClient.iClient.GetListOfSnapshotsForAnInstance(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+	snapshotID := instanceCheckSnaphotByName(name, snapshotResp)
+	if snapshotID == -1 {
+		return fmt.Errorf("snapshot with name %s is not found for the instance %d", name, instanceID)
+	}
+
+	// revert process is identified as the process created after the revert request
+	history, err := sharedClient.iClient.GetInstanceHistory(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+	lastProcessID := 0
+	for _, process := range history.Processes {
+		if process.ID > lastProcessID {
+			lastProcessID = process.ID
+		}
+	}
+
+	revertResp, err := sharedClient.api.RevertSnapshot(ctx, instanceID, snapshotID)
+	if err != nil {
+		return err
+	}
+	if !revertResp.Success {
+		return fmt.Errorf(successErr, "reverting instance to the snapshot")
+	}
+
+	if err := instanceWaitForProcess(ctx, sharedClient, meta, instanceID, lastProcessID, timeout); err != nil {
+		return err
+	}
+
+	return instanceWaitUntilCreated(ctx, sharedClient, meta, instanceID, timeout)
+}
+
+// instanceWaitForProcess waits until the first process created after lastProcessID is
+// completed, returns error if the process is failed
+func instanceWaitForProcess(
+	ctx context.Context,
+	sharedClient instanceSharedClient,
+	meta interface{},
+	instanceID int,
+	lastProcessID int,
+	timeout time.Duration,
+) error {
+	historyRetry := utils.CustomRetry{
+		InitialDelay: retryInitialDelay,
+		Backoff:      utils.DecorrelatedJitterBackoff(retryInitialDelay, maxRetryDelay),
+		Timeout:      timeout,
+		Cond: utils.PollErrorCond(maxErrCount, func(response interface{}) (bool, error) {
+			var process *models.GetInstanceHistoryProcesses
+			instanceHistory := response.(models.GetInstanceHistory)
+			for i, p := range instanceHistory.Processes {
+				if p.ID > lastProcessID && (process == nil || p.ID < process.ID) {
+					process = &instanceHistory.Processes[i]
+				}
+			}
+			if process == nil {
+				return false, nil
+			}
+			if process.Status == "failed" {
+				return false, fmt.Errorf("%s process of the instance is failed", process.DisplayName)
+			}
+
+			return process.Status == "success" || process.Status == "complete", nil
+		}),
+	}
+	_, err := historyRetry.Retry(ctx, meta, func(ctx context.Context) (interface{}, error) {
+		return sharedClient.iClient.GetInstanceHistory(ctx, instanceID)
+	})
+
+	return err
+}
+
 func instanceGetSnaphotModel(snapshot models.TFInstanceSnapshot, retry *utils.CustomRetry) models.TFInstanceSnapshot {
 	if utils.IsEmpty(snapshot) {
 		return snapshot
@@ -467,41 +749,26 @@ func instanceCheckSnaphotByName(name string, snapshotResp interface{}) int {
 	return -1
 }
 
-func instanceWaitUntilCreated(ctx context.Context, sharedClient instanceSharedClient, meta interface{}, instanceID int) error {
-	errCount := 0
+func instanceWaitUntilCreated(
+	ctx context.Context,
+	sharedClient instanceSharedClient,
+	meta interface{},
+	instanceID int,
+	timeout time.Duration,
+) error {
 	cRetry := utils.CustomRetry{
-		Timeout:      maxTimeout,
-		RetryDelay:   time.Second * 15,
+		Timeout:      timeout,
+		Backoff:      utils.ExponentialBackoff(time.Second*15, maxRetryDelay),
 		InitialDelay: time.Minute,
-		Cond: func(response interface{}, err error) (bool, error) {
-			if err != nil {
-				errCount++
-				// return false as condition if same error returns 3 times.
-				if errCount == 3 {
-					return false, err
-				}
-
-				return false, nil
-			}
-
+		Cond: utils.PollErrorCond(maxErrCount, func(response interface{}) (bool, error) {
 			instance, ok := response.(models.GetInstanceResponse)
 			if !ok {
-				errCount++
-				if errCount == 3 {
-					return false, fmt.Errorf("%s", "error while getting instance")
-				}
-
-				return false, nil
-			}
-			errCount = 0
-
-			if instance.Instance.Status == utils.StateFailed ||
-				instance.Instance.Status == utils.StateRunning {
-				return true, nil
+				return false, fmt.Errorf("%s", "error while getting instance")
 			}
 
-			return false, nil
-		},
+			return instance.Instance.Status == utils.StateFailed ||
+				instance.Instance.Status == utils.StateRunning, nil
+		}),
 	}
 
 	_, err := cRetry.Retry(ctx, meta, func(ctx context.Context) (interface{}, error) {
@@ -526,16 +793,20 @@ func instanceGetHistoryModel(retry *utils.CustomRetry) []models.GetInstanceHisto
 	return historyModel.Processes
 }
 
-func instanceGetResizeNetwork(network []map[string]interface{}) []models.CreateInstanceBodyNetworkInterfaces {
-	nics := make([]models.CreateInstanceBodyNetworkInterfaces, 0, len(network))
+func instanceGetResizeNetwork(network []map[string]interface{}) []instanceNetworkInterface {
+	nics := make([]instanceNetworkInterface, 0, len(network))
 	for _, n := range network {
-		nics = append(nics, models.CreateInstanceBodyNetworkInterfaces{
-			Name: n["name"].(string),
-			ID:   n["internal_id"].(int),
-			Network: &models.CreateInstanceBodyNetwork{
-				ID: n["id"].(int),
+		nics = append(nics, instanceNetworkInterface{
+			CreateInstanceBodyNetworkInterfaces: models.CreateInstanceBodyNetworkInterfaces{
+				Name: n["name"].(string),
+				ID:   n["internal_id"].(int),
+				Network: &models.CreateInstanceBodyNetwork{
+					ID: n["id"].(int),
+				},
+				NetworkInterfaceTypeID: utils.JSONNumber(n["interface_id"]),
 			},
-			NetworkInterfaceTypeID: utils.JSONNumber(n["interface_id"]),
+			IPMode:    n["ip_mode"].(string),
+			IPAddress: n["ip_address"].(string),
 		})
 	}
 
@@ -560,23 +831,167 @@ func instanceSetServerID(ctx context.Context, d *utils.Data, sharedClient instan
 	return nil
 }
 
+// instanceGetNetworkModel rebuilds the network model from instance and server interfaces.
+// Both instance and server returns interfaces in the same order. Interfaces are matched
+// with the state by internal ID and then by network ID, so the order in the state is
+// retained. Interfaces which are not in the state will be appended to the end.
 func instanceGetNetworkModel(
-	networks []models.TFInstanceNetwork, retry *utils.CustomRetry) ([]models.TFInstanceNetwork, error) {
+	networks []tfInstanceNetwork,
+	iModels []models.GetInstanceResponseInstanceInterfaces,
+	retry *utils.CustomRetry,
+) ([]tfInstanceNetwork, error) {
 	resp, err := retry.Wait()
 	if err != nil {
 		return nil, err
 	}
 	serverInterface := resp.(models.GetSpecificServerResponse).Server.Interfaces
-	if len(serverInterface) != len(networks) {
-		return nil, fmt.Errorf("failed to set network. There is mismatch on created network and the terraform state")
-	}
+
+	respNetworks := make([]tfInstanceNetwork, 0, len(serverInterface))
 	for i, s := range serverInterface {
-		networks[i].InternalID = s.ID
-		networks[i].IsPrimary = s.PrimaryInterface
-		networks[i].Name = s.Name
+		network := tfInstanceNetwork{
+			InternalID: s.ID,
+			IsPrimary:  s.PrimaryInterface,
+			Name:       s.Name,
+			IPAddress:  s.IPAddress,
+		}
+		if i < len(iModels) {
+			if iModels[i].Network != nil {
+				networkID, _ := iModels[i].Network.ID.Int64()
+				network.ID = int(networkID)
+			}
+			interfaceID, _ := iModels[i].NetworkInterfaceTypeID.Int64()
+			network.InterfaceID = int(interfaceID)
+		}
+		respNetworks = append(respNetworks, network)
+	}
+
+	matched := make([]bool, len(respNetworks))
+	tfNetworks := make([]tfInstanceNetwork, 0, len(respNetworks))
+	for _, n := range networks {
+		index := -1
+		for i, r := range respNetworks {
+			if !matched[i] && n.InternalID != 0 && n.InternalID == r.InternalID {
+				index = i
+
+				break
+			}
+		}
+		if index == -1 {
+			for i, r := range respNetworks {
+				if !matched[i] && n.InternalID == 0 && n.ID == r.ID {
+					index = i
+
+					break
+				}
+			}
+		}
+		// interface is removed outside terraform
+		if index == -1 {
+			continue
+		}
+		matched[index] = true
+		// network interface type is not returned always
+		if respNetworks[index].InterfaceID == 0 {
+			respNetworks[index].InterfaceID = n.InterfaceID
+		}
+		instanceSetNetworkAddressing(&respNetworks[index], n)
+		tfNetworks = append(tfNetworks, respNetworks[index])
+	}
+	for i := range respNetworks {
+		if !matched[i] {
+			instanceSetNetworkAddressing(&respNetworks[i], tfInstanceNetwork{})
+			tfNetworks = append(tfNetworks, respNetworks[i])
+		}
+	}
+
+	return tfNetworks, nil
+}
+
+// instanceSetNetworkAddressing sets the addressing of the interface. IP mode is retained
+// from the state and defaults to dhcp for the interfaces which are not in the state. IP
+// address is tracked only for the static interfaces, rest are assigned by CMP.
+func instanceSetNetworkAddressing(network *tfInstanceNetwork, state tfInstanceNetwork) {
+	network.IPMode = state.IPMode
+	if network.IPMode == "" {
+		network.IPMode = utils.IPModeDhcp
+	}
+	if network.IPMode != utils.IPModeStatic {
+		network.IPAddress = ""
+	} else if network.IPAddress == "" {
+		network.IPAddress = state.IPAddress
+	}
+}
+
+// instanceGetVolumeModel rebuilds the volume model from the instance response. Volumes are
+// matched with the state by ID and then by name, so the order in the state is retained.
+// Volumes which are not in the state will be appended to the end, except for cloned
+// instances, since cloned instance inherits volumes from the source instance.
+func instanceGetVolumeModel(
+	volumes []models.TFInstanceVolume,
+	vModels []models.GetInstanceResponseInstanceVolumes,
+	isClone bool,
+) []models.TFInstanceVolume {
+	matched := make([]bool, len(vModels))
+	tfVolumes := make([]models.TFInstanceVolume, 0, len(vModels))
+	for _, v := range volumes {
+		index := -1
+		for i, vModel := range vModels {
+			if !matched[i] && v.ID != 0 && v.ID == vModel.ID {
+				index = i
+
+				break
+			}
+		}
+		if index == -1 {
+			for i, vModel := range vModels {
+				if !matched[i] && v.Name == vModel.Name {
+					index = i
+
+					break
+				}
+			}
+		}
+		// volume is removed outside terraform
+		if index == -1 {
+			continue
+		}
+		matched[index] = true
+		tfVolumes = append(tfVolumes, models.TFInstanceVolume{
+			ID:   vModels[index].ID,
+			Name: vModels[index].Name,
+			Size: vModels[index].Size,
+			Root: vModels[index].RootVolume,
+			// datastore changes are ignored on diff, so retain the state value
+			DatastoreID: v.DatastoreID,
+		})
+	}
+	if isClone {
+		return tfVolumes
+	}
+	for i, vModel := range vModels {
+		if !matched[i] {
+			tfVolumes = append(tfVolumes, instanceImportVolume(vModel))
+		}
+	}
+
+	return tfVolumes
+}
+
+// instanceSetAttributes sets the attributes which can be changed outside terraform
+func instanceSetAttributes(d *utils.Data, instance *models.GetInstanceResponseInstance) error {
+	state := map[string]interface{}{
+		"name":             instance.Name,
+		"environment_code": instance.InstanceContext,
+		"labels":           instance.Labels,
+	}
+	if instance.Group != nil {
+		state["group_id"] = instance.Group.ID
+	}
+	if instance.Plan != nil {
+		state["plan_id"] = instance.Plan.ID
 	}
 
-	return networks, nil
+	return setState(d, state)
 }
 
 func instanceUpdateNetworkVolumePlan(
@@ -609,12 +1024,13 @@ func instanceUpdateNetworkVolumePlan(
 		}
 	}
 
+	req := resizeInstanceRequest{ResizeInstanceBody: &resizeReq}
 	if d.HasChanged("network") {
 		schemaNetwork := d.GetListMap("network")
-		resizeReq.NetworkInterfaces = instanceGetResizeNetwork(schemaNetwork)
+		req.NetworkInterfaces = instanceGetResizeNetwork(schemaNetwork)
 	}
 	if d.HasChanged("volume") || d.HasChanged("network") || d.HasChanged("plan_id") {
-		updateResp, err := sharedClient.iClient.ResizeAnInstance(ctx, instanceID, &resizeReq)
+		updateResp, err := sharedClient.api.ResizeInstance(ctx, instanceID, req)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,188 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package cmp
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/tshihad/tftags"
+)
+
+const (
+	instanceSnapshotRetryDelay = time.Second * 15
+)
+
+// tfInstanceSnapshot is the terraform model for hpegl_vmaas_instance_snapshot
+type tfInstanceSnapshot struct {
+	ID              int    `tf:"id,computed"`
+	InstanceID      int    `tf:"instance_id"`
+	Name            string `tf:"name"`
+	Description     string `tf:"description"`
+	Status          string `tf:"status,computed"`
+	ExternalID      string `tf:"external_id,computed"`
+	DateCreated     string `tf:"date_created,computed"`
+	CurrentlyActive bool   `tf:"currently_active,computed"`
+}
+
+// instanceSnapshot implements functions related to snapshots of an instance
+type instanceSnapshot struct {
+	iClient *client.InstancesAPIService
+	api     *apiService
+}
+
+func newInstanceSnapshot(iClient *client.InstancesAPIService, api *apiService) *instanceSnapshot {
+	return &instanceSnapshot{
+		iClient: iClient,
+		api:     api,
+	}
+}
+
+func (i *instanceSnapshot) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
+	setMeta(meta, i.iClient.Client)
+	var tfSnapshot tfInstanceSnapshot
+	if err := tftags.Get(d, &tfSnapshot); err != nil {
+		return err
+	}
+
+	resp, err := i.iClient.GetListOfSnapshotsForAnInstance(ctx, tfSnapshot.InstanceID)
+	if err != nil {
+		return handleNotFound(d, err, "Instance snapshot")
+	}
+	snapshot := instanceSnapshotGetByID(tfSnapshot.ID, resp)
+	if snapshot == nil {
+		log.Printf("[WARN] Snapshot %d of instance %d is not found, removing from state",
+			tfSnapshot.ID, tfSnapshot.InstanceID)
+		d.SetID("")
+
+		return nil
+	}
+	instanceSnapshotSetModel(&tfSnapshot, snapshot)
+	if err := tftags.Set(d, tfSnapshot); err != nil {
+		return err
+	}
+
+	// name and description are not computed, hence tftags.Set will not set them
+	return setState(d, map[string]interface{}{
+		"name":        tfSnapshot.Name,
+		"description": tfSnapshot.Description,
+	})
+}
+
+func (i *instanceSnapshot) Create(ctx context.Context, d *utils.Data, meta interface{}) error {
+	setMeta(meta, i.iClient.Client)
+	var tfSnapshot tfInstanceSnapshot
+	if err := tftags.Get(d, &tfSnapshot); err != nil {
+		return err
+	}
+
+	// snapshot name is used to identify the snapshot after creation, so
+	// the name should be unique for an instance
+	resp, err := i.iClient.GetListOfSnapshotsForAnInstance(ctx, tfSnapshot.InstanceID)
+	if err != nil {
+		return err
+	}
+	if instanceCheckSnaphotByName(tfSnapshot.Name, resp) != -1 {
+		return fmt.Errorf("snapshot with name %s already exists for the instance %d",
+			tfSnapshot.Name, tfSnapshot.InstanceID)
+	}
+
+	err = createInstanceSnapshot(ctx, instanceSharedClient{iClient: i.iClient}, tfSnapshot.InstanceID,
+		models.SnapshotBody{
+			Snapshot: &models.SnapshotBodySnapshot{
+				Name:        tfSnapshot.Name,
+				Description: tfSnapshot.Description,
+			},
+		})
+	if err != nil {
+		return err
+	}
+
+	// Snapshot creation is asynchronous, so wait until the snapshot is listed
+	// under the instance
+	snapshotRetry := utils.CustomRetry{
+		InitialDelay: instanceSnapshotRetryDelay,
+		RetryDelay:   instanceSnapshotRetryDelay,
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Cond: utils.PollErrorCond(maxErrCount, func(response interface{}) (bool, error) {
+			return instanceCheckSnaphotByName(tfSnapshot.Name, response) != -1, nil
+		}),
+	}
+	snapshotResp, err := snapshotRetry.Retry(ctx, meta, func(ctx context.Context) (interface{}, error) {
+		return i.iClient.GetListOfSnapshotsForAnInstance(ctx, tfSnapshot.InstanceID)
+	})
+	if err != nil {
+		return err
+	}
+	tfSnapshot.ID = instanceCheckSnaphotByName(tfSnapshot.Name, snapshotResp)
+
+	return tftags.Set(d, tfSnapshot)
+}
+
+// Update is not supported, since all the attributes are ForceNew
+func (i *instanceSnapshot) Update(ctx context.Context, d *utils.Data, meta interface{}) error {
+	return nil
+}
+
+func (i *instanceSnapshot) Delete(ctx context.Context, d *utils.Data, meta interface{}) error {
+	setMeta(meta, i.iClient.Client)
+	i.api.setMeta(meta)
+	var tfSnapshot tfInstanceSnapshot
+	if err := tftags.Get(d, &tfSnapshot); err != nil {
+		return err
+	}
+
+	resp, err := i.api.DeleteSnapshot(ctx, tfSnapshot.ID)
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "deleting snapshot of the instance")
+	}
+
+	// wait until the snapshot is removed from the instance
+	deleteRetry := utils.CustomRetry{
+		RetryDelay: instanceSnapshotRetryDelay,
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Cond: utils.PollErrorCond(maxErrCount, func(response interface{}) (bool, error) {
+			return instanceSnapshotGetByID(tfSnapshot.ID, response.(models.ListSnapshotResponse)) == nil, nil
+		}),
+	}
+	_, err = deleteRetry.Retry(ctx, meta, func(ctx context.Context) (interface{}, error) {
+		return i.iClient.GetListOfSnapshotsForAnInstance(ctx, tfSnapshot.InstanceID)
+	})
+
+	return err
+}
+
+// Import snapshot with the ID in the format '<instance_id>/<snapshot_id>'
+func (i *instanceSnapshot) Import(ctx context.Context, d *utils.Data, meta interface{}) error {
+	return importChild(ctx, d, meta, "instance_id", i)
+}
+
+func instanceSnapshotGetByID(id int, snapshots models.ListSnapshotResponse) *models.ListSnapshotResponseInstance {
+	for i := range snapshots.Snapshots {
+		if snapshots.Snapshots[i].ID == id {
+			return &snapshots.Snapshots[i]
+		}
+	}
+
+	return nil
+}
+
+func instanceSnapshotSetModel(tfSnapshot *tfInstanceSnapshot, snapshot *models.ListSnapshotResponseInstance) {
+	tfSnapshot.Name = snapshot.Name
+	if description, ok := snapshot.Description.(string); ok {
+		tfSnapshot.Description = description
+	}
+	tfSnapshot.Status = snapshot.Status
+	tfSnapshot.ExternalID = snapshot.ExternalID
+	tfSnapshot.DateCreated = snapshot.DateCreated
+	tfSnapshot.CurrentlyActive = snapshot.CurrentlyActive
+}
@@ -31,3 +31,12 @@ type Resource interface {
 type DataSource interface {
 	Read(context.Context, *utils.Data, interface{}) error
 }
+
+// Importer interface wraps terraform import operation. Resource clients
+// which can not rebuild the state from resource ID alone are expected
+// to implement this function.
+type Importer interface {
+	// Import terraform operations. Context and resource data as params.
+	// will return error
+	Import(context.Context, *utils.Data, interface{}) error
+}
@@ -0,0 +1,163 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package cmp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/tshihad/tftags"
+)
+
+// tfIPAddress is the terraform model for hpegl_vmaas_ip_address
+type tfIPAddress struct {
+	ID         int      `tf:"id,computed"`
+	PoolID     int      `tf:"pool_id"`
+	IPAddress  string   `tf:"ip_address"`
+	Hostname   string   `tf:"hostname"`
+	Gateway    string   `tf:"gateway,computed"`
+	Netmask    string   `tf:"netmask,computed"`
+	DNSServers []string `tf:"dns_servers,computed"`
+}
+
+// ipAddress implements functions related to IP addresses allocated from
+// the IP pools
+type ipAddress struct {
+	api *apiService
+}
+
+func newIPAddress(api *apiService) *ipAddress {
+	return &ipAddress{
+		api: api,
+	}
+}
+
+func (i *ipAddress) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
+	i.api.setMeta(meta)
+	var tfIP tfIPAddress
+	if err := tftags.Get(d, &tfIP); err != nil {
+		return err
+	}
+
+	resp, err := i.api.GetNetworkPoolIP(ctx, tfIP.PoolID, tfIP.ID)
+	if err != nil {
+		return handleNotFound(d, err, "IP address")
+	}
+	poolResp, err := i.api.GetNetworkPool(ctx, tfIP.PoolID)
+	if err != nil {
+		return err
+	}
+	pool := poolResp.NetworkPool
+
+	return setState(d, map[string]interface{}{
+		"ip_address":  resp.NetworkPoolIP.IPAddress,
+		"hostname":    resp.NetworkPoolIP.Hostname,
+		"gateway":     pool.Gateway,
+		"netmask":     pool.Netmask,
+		"dns_servers": pool.DNSServers,
+	})
+}
+
+// Import IP address with the ID in the format '<pool_id>/<ip_address_id>'
+func (i *ipAddress) Import(ctx context.Context, d *utils.Data, meta interface{}) error {
+	return importChild(ctx, d, meta, "pool_id", i)
+}
+
+func (i *ipAddress) Create(ctx context.Context, d *utils.Data, meta interface{}) error {
+	i.api.setMeta(meta)
+	var tfIP tfIPAddress
+	if err := tftags.Get(d, &tfIP); err != nil {
+		return err
+	}
+
+	if tfIP.IPAddress == "" {
+		ip, err := i.nextFreeIP(ctx, tfIP.PoolID)
+		if err != nil {
+			return err
+		}
+		tfIP.IPAddress = ip
+	}
+
+	resp, err := i.api.CreateNetworkPoolIP(ctx, tfIP.PoolID, networkPoolIPToRequest(tfIP))
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "allocating IP address from the network pool")
+	}
+	tfIP.ID = resp.ID
+
+	return tftags.Set(d, tfIP)
+}
+
+func (i *ipAddress) Update(ctx context.Context, d *utils.Data, meta interface{}) error {
+	i.api.setMeta(meta)
+	var tfIP tfIPAddress
+	if err := tftags.Get(d, &tfIP); err != nil {
+		return err
+	}
+
+	resp, err := i.api.UpdateNetworkPoolIP(ctx, tfIP.PoolID, tfIP.ID, networkPoolIPToRequest(tfIP))
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "updating IP address of the network pool")
+	}
+
+	return nil
+}
+
+func (i *ipAddress) Delete(ctx context.Context, d *utils.Data, meta interface{}) error {
+	i.api.setMeta(meta)
+	var tfIP tfIPAddress
+	if err := tftags.Get(d, &tfIP); err != nil {
+		return err
+	}
+
+	resp, err := i.api.DeleteNetworkPoolIP(ctx, tfIP.PoolID, tfIP.ID)
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "releasing IP address to the network pool")
+	}
+
+	return nil
+}
+
+// nextFreeIP returns the first IP address of the pool ranges, which is neither
+// allocated nor the gateway of the pool
+func (i *ipAddress) nextFreeIP(ctx context.Context, poolID int) (string, error) {
+	poolResp, err := i.api.GetNetworkPool(ctx, poolID)
+	if err != nil {
+		return "", err
+	}
+	ipsResp, err := i.api.GetNetworkPoolIPs(ctx, poolID)
+	if err != nil {
+		return "", err
+	}
+
+	pool := poolResp.NetworkPool
+	used := make([]string, 0, len(ipsResp.NetworkPoolIPs)+1)
+	used = append(used, pool.Gateway)
+	for _, ip := range ipsResp.NetworkPoolIPs {
+		used = append(used, ip.IPAddress)
+	}
+	ranges := make([]utils.IPRange, 0, len(pool.IPRanges))
+	for _, r := range pool.IPRanges {
+		ranges = append(ranges, utils.IPRange{StartAddress: r.StartAddress, EndAddress: r.EndAddress})
+	}
+
+	return utils.NextFreeIP(ranges, used)
+}
+
+func networkPoolIPToRequest(tfIP tfIPAddress) networkPoolIPRequest {
+	return networkPoolIPRequest{
+		NetworkPoolIP: networkPoolIPBody{
+			IPAddress: tfIP.IPAddress,
+			Hostname:  tfIP.Hostname,
+		},
+	}
+}
@@ -5,11 +5,11 @@ package cmp
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
 	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tshihad/tftags"
 )
 
@@ -33,10 +33,22 @@ func (lb *loadBalancerMonitor) Read(ctx context.Context, d *utils.Data, meta int
 	getMonitorLoadBalancer, err := lb.lbClient.GetSpecificLBMonitor(ctx, lbMonitorResp.LbID,
 		lbMonitorResp.ID)
 	if err != nil {
+		return handleNotFound(d, err, "Load balancer monitor")
+	}
+
+	if err := tftags.Set(d, getMonitorLoadBalancer.GetSpecificLBMonitorResp); err != nil {
 		return err
 	}
 
-	return tftags.Set(d, getMonitorLoadBalancer.GetSpecificLBMonitorResp)
+	return setState(d, map[string]interface{}{
+		"name":        getMonitorLoadBalancer.GetSpecificLBMonitorResp.Name,
+		"description": getMonitorLoadBalancer.GetSpecificLBMonitorResp.Description,
+	})
+}
+
+// Import load balancer monitor with the ID in the format '<lb_id>/<monitor_id>'
+func (lb *loadBalancerMonitor) Import(ctx context.Context, d *utils.Data, meta interface{}) error {
+	return importChild(ctx, d, meta, "lb_id", lb)
 }
 
 func (lb *loadBalancerMonitor) Create(ctx context.Context, d *utils.Data, meta interface{}) error {
@@ -63,8 +75,9 @@ func (lb *loadBalancerMonitor) Create(ctx context.Context, d *utils.Data, meta i
 
 	// wait until created
 	retry := &utils.CustomRetry{
-		InitialDelay: time.Second * 15,
-		RetryDelay:   time.Second * 30,
+		InitialDelay: retryInitialDelay,
+		RetryDelay:   retryDelay,
+		Timeout:      d.Timeout(schema.TimeoutCreate),
 	}
 	_, err = retry.Retry(ctx, meta, func(ctx context.Context) (interface{}, error) {
 		return lb.lbClient.GetSpecificLBMonitor(ctx, createReq.CreateLBMonitorReq.LbID,
@@ -110,8 +123,10 @@ func (lb *loadBalancerMonitor) Update(ctx context.Context, d *utils.Data, meta i
 	}
 
 	retry := &utils.CustomRetry{
-		InitialDelay: time.Second * 15,
-		RetryDelay:   time.Second * 30,
+		InitialDelay: retryInitialDelay,
+		RetryDelay:   retryDelay,
+		Timeout:      d.Timeout(schema.TimeoutUpdate),
+		Cond:         utils.ErrorCond(maxErrCount, utils.AnyResponse),
 	}
 	_, err := retry.Retry(ctx, meta, func(ctx context.Context) (interface{}, error) {
 		return lb.lbClient.UpdateLBMonitor(ctx, updateReq,
@@ -5,48 +5,82 @@ package cmp
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
 	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tshihad/tftags"
 )
 
+// tfLBPoolMembers is the terraform model for static members of the pool, which
+// are not available in models.CreateLBPoolReq
+type tfLBPoolMembers struct {
+	Config *tfLBPoolMemberConfig `tf:"config,sub"`
+}
+
+type tfLBPoolMemberConfig struct {
+	Members []lbPoolMember `tf:"member"`
+}
+
 type loadBalancerPool struct {
 	lbClient *client.LoadBalancerAPIService
+	api      *apiService
 }
 
-func newLoadBalancerPool(loadBalancerClient *client.LoadBalancerAPIService) *loadBalancerPool {
+func newLoadBalancerPool(loadBalancerClient *client.LoadBalancerAPIService, api *apiService) *loadBalancerPool {
 	return &loadBalancerPool{
 		lbClient: loadBalancerClient,
+		api:      api,
 	}
 }
 
 func (lb *loadBalancerPool) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
-	setMeta(meta, lb.lbClient.Client)
+	lb.api.setMeta(meta)
 	var lbPoolResp models.CreateLBPoolReq
 	if err := tftags.Get(d, &lbPoolResp); err != nil {
 		return err
 	}
 
-	getPoolLoadBalancer, err := lb.lbClient.GetSpecificLBPool(ctx, lbPoolResp.LbID, lbPoolResp.ID)
+	getPoolLoadBalancer, err := lb.api.GetLBPool(ctx, lbPoolResp.LbID, lbPoolResp.ID)
 	if err != nil {
+		return handleNotFound(d, err, "Load balancer pool")
+	}
+
+	pool := getPoolLoadBalancer.LoadBalancerPool
+	if err := tftags.Set(d, pool.GetSpecificLBPoolResp); err != nil {
 		return err
 	}
 
-	return tftags.Set(d, getPoolLoadBalancer.GetSpecificLBPoolResp)
+	return setState(d, map[string]interface{}{
+		"name":               pool.Name,
+		"description":        pool.Description,
+		"algorithm":          pool.VipBalance,
+		"min_active_members": pool.MinActive,
+		"config":             lbPoolConfigToList(pool.Config),
+	})
+}
+
+// Import load balancer pool with the ID in the format '<lb_id>/<pool_id>'
+func (lb *loadBalancerPool) Import(ctx context.Context, d *utils.Data, meta interface{}) error {
+	return importChild(ctx, d, meta, "lb_id", lb)
 }
 
 func (lb *loadBalancerPool) Create(ctx context.Context, d *utils.Data, meta interface{}) error {
 	setMeta(meta, lb.lbClient.Client)
+	lb.api.setMeta(meta)
 
 	var createReq models.CreateLBPool
 	if err := tftags.Get(d, &createReq.CreateLBPoolReq); err != nil {
 		return err
 	}
+	var tfMembers tfLBPoolMembers
+	if err := tftags.Get(d, &tfMembers); err != nil {
+		return err
+	}
 
-	lbPoolResp, err := lb.lbClient.CreateLBPool(ctx, createReq, createReq.CreateLBPoolReq.LbID)
+	lbPoolResp, err := lb.api.CreateLBPool(ctx, createReq.CreateLBPoolReq.LbID,
+		lbPoolToRequest(createReq.CreateLBPoolReq, tfMembers))
 	if err != nil {
 		return err
 	}
@@ -56,8 +90,9 @@ func (lb *loadBalancerPool) Create(ctx context.Context, d *utils.Data, meta inte
 	createReq.CreateLBPoolReq.ID = lbPoolResp.LBPoolResp.ID
 	// wait until created
 	retry := &utils.CustomRetry{
-		InitialDelay: time.Second * 15,
-		RetryDelay:   time.Second * 30,
+		InitialDelay: retryInitialDelay,
+		RetryDelay:   retryDelay,
+		Timeout:      d.Timeout(schema.TimeoutCreate),
 	}
 	_, err = retry.Retry(ctx, meta, func(ctx context.Context) (interface{}, error) {
 		return lb.lbClient.GetSpecificLBPool(ctx, createReq.CreateLBPoolReq.LbID,
@@ -71,20 +106,27 @@ func (lb *loadBalancerPool) Create(ctx context.Context, d *utils.Data, meta inte
 }
 
 func (lb *loadBalancerPool) Update(ctx context.Context, d *utils.Data, meta interface{}) error {
+	lb.api.setMeta(meta)
 	id := d.GetID()
 
 	var updateReq models.CreateLBPool
 	if err := tftags.Get(d, &updateReq.CreateLBPoolReq); err != nil {
 		return err
 	}
+	var tfMembers tfLBPoolMembers
+	if err := tftags.Get(d, &tfMembers); err != nil {
+		return err
+	}
 
 	retry := &utils.CustomRetry{
-		InitialDelay: time.Second * 15,
-		RetryDelay:   time.Second * 30,
+		InitialDelay: retryInitialDelay,
+		RetryDelay:   retryDelay,
+		Timeout:      d.Timeout(schema.TimeoutUpdate),
+		Cond:         utils.ErrorCond(maxErrCount, utils.AnyResponse),
 	}
 	_, err := retry.Retry(ctx, meta, func(ctx context.Context) (interface{}, error) {
-		return lb.lbClient.UpdateLBPool(ctx, updateReq,
-			updateReq.CreateLBPoolReq.LbID, id)
+		return lb.api.UpdateLBPool(ctx, updateReq.CreateLBPoolReq.LbID, id,
+			lbPoolToRequest(updateReq.CreateLBPoolReq, tfMembers))
 	})
 	if err != nil {
 		return err
@@ -111,3 +153,64 @@ func (lb *loadBalancerPool) Delete(ctx context.Context, d *utils.Data, meta inte
 
 	return nil
 }
+
+// lbPoolToRequest populates the pool request along with the static members
+func lbPoolToRequest(tfPool models.CreateLBPoolReq, tfMembers tfLBPoolMembers) lbPoolRequest {
+	req := lbPoolRequest{
+		LoadBalancerPool: lbPoolBody{CreateLBPoolReq: tfPool},
+	}
+	if tfPool.PoolConfig == nil && tfMembers.Config == nil {
+		return req
+	}
+
+	members := []lbPoolMember{}
+	if tfMembers.Config != nil && tfMembers.Config.Members != nil {
+		members = tfMembers.Config.Members
+	}
+	req.LoadBalancerPool.Config = &lbPoolConfig{
+		PoolConfig: tfPool.PoolConfig,
+		Members:    members,
+	}
+
+	return req
+}
+
+func lbPoolConfigToList(poolConfig lbPoolConfig) []map[string]interface{} {
+	config := map[string]interface{}{
+		"member": lbPoolMembersToList(poolConfig.Members),
+	}
+	if poolConfig.PoolConfig != nil {
+		config["snat_translation_type"] = poolConfig.SnatTranslationType
+		config["passive_monitor_path"] = poolConfig.PassiveMonitorPath
+		config["active_monitor_paths"] = poolConfig.ActiveMonitorPaths
+		config["tcp_multiplexing"] = poolConfig.TCPMultiplexing
+		config["tcp_multiplexing_number"] = poolConfig.TCPMultiplexingNumber
+		config["snat_ip_address"] = poolConfig.SnatIPAddress
+		if memberGroup := poolConfig.MemberGroup; memberGroup != nil && memberGroup.Group != "" {
+			config["member_group"] = []map[string]interface{}{{
+				"group":              memberGroup.Group,
+				"max_ip_list_size":   memberGroup.MaxIPListSize,
+				"ip_revision_filter": memberGroup.IPRevisionFilter,
+				"port":               memberGroup.Port,
+			}}
+		}
+	}
+
+	return []map[string]interface{}{config}
+}
+
+func lbPoolMembersToList(members []lbPoolMember) []map[string]interface{} {
+	list := make([]map[string]interface{}, 0, len(members))
+	for _, m := range members {
+		list = append(list, map[string]interface{}{
+			"name":          m.Name,
+			"ip_address":    m.IPAddress,
+			"port":          m.Port,
+			"weight":        m.Weight,
+			"admin_state":   m.AdminState,
+			"backup_member": m.BackupMember,
+		})
+	}
+
+	return list
+}
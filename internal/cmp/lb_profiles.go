@@ -5,11 +5,11 @@ package cmp
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
 	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tshihad/tftags"
 )
 
@@ -33,10 +33,22 @@ func (lb *loadBalancerProfile) Read(ctx context.Context, d *utils.Data, meta int
 
 	getProfileLoadBalancer, err := lb.lbClient.GetSpecificLBProfile(ctx, lbProfileResp.LbID, lbProfileResp.ID)
 	if err != nil {
+		return handleNotFound(d, err, "Load balancer profile")
+	}
+
+	if err := tftags.Set(d, getProfileLoadBalancer.GetLBSpecificProfilesResp); err != nil {
 		return err
 	}
 
-	return tftags.Set(d, getProfileLoadBalancer.GetLBSpecificProfilesResp)
+	return setState(d, map[string]interface{}{
+		"name":        getProfileLoadBalancer.GetLBSpecificProfilesResp.Name,
+		"description": getProfileLoadBalancer.GetLBSpecificProfilesResp.Description,
+	})
+}
+
+// Import load balancer profile with the ID in the format '<lb_id>/<profile_id>'
+func (lb *loadBalancerProfile) Import(ctx context.Context, d *utils.Data, meta interface{}) error {
+	return importChild(ctx, d, meta, "lb_id", lb)
 }
 
 func (lb *loadBalancerProfile) Create(ctx context.Context, d *utils.Data, meta interface{}) error {
@@ -63,8 +75,9 @@ func (lb *loadBalancerProfile) Create(ctx context.Context, d *utils.Data, meta i
 
 	// wait until created
 	retry := &utils.CustomRetry{
-		InitialDelay: time.Second * 15,
-		RetryDelay:   time.Second * 30,
+		InitialDelay: retryInitialDelay,
+		RetryDelay:   retryDelay,
+		Timeout:      d.Timeout(schema.TimeoutCreate),
 	}
 	_, err = retry.Retry(ctx, meta, func(ctx context.Context) (interface{}, error) {
 		return lb.lbClient.GetSpecificLBProfile(ctx, createReq.CreateLBProfileReq.LbID,
@@ -110,8 +123,10 @@ func (lb *loadBalancerProfile) Update(ctx context.Context, d *utils.Data, meta i
 	}
 
 	retry := &utils.CustomRetry{
-		InitialDelay: time.Second * 15,
-		RetryDelay:   time.Second * 30,
+		InitialDelay: retryInitialDelay,
+		RetryDelay:   retryDelay,
+		Timeout:      d.Timeout(schema.TimeoutUpdate),
+		Cond:         utils.ErrorCond(maxErrCount, utils.AnyResponse),
 	}
 	_, err := retry.Retry(ctx, meta, func(ctx context.Context) (interface{}, error) {
 		return lb.lbClient.UpdateLBProfile(ctx, updateReq,
@@ -5,46 +5,80 @@ package cmp
 import (
 	"context"
 	"fmt"
-	"time"
+	"strconv"
 
 	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
 	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tshihad/tftags"
 )
 
+// tfLBVirtualServerRules is the terraform model for rules of the virtual server,
+// which are not available in models.CreateLBVirtualServersReq
+type tfLBVirtualServerRules struct {
+	Rules []lbVirtualServerRule `tf:"rule"`
+}
+
 type loadBalancerVirtualServer struct {
 	lbClient *client.LoadBalancerAPIService
+	api      *apiService
 }
 
-func newLoadBalancerVirtualServer(loadBalancerClient *client.LoadBalancerAPIService) *loadBalancerVirtualServer {
+func newLoadBalancerVirtualServer(
+	loadBalancerClient *client.LoadBalancerAPIService,
+	api *apiService,
+) *loadBalancerVirtualServer {
 	return &loadBalancerVirtualServer{
 		lbClient: loadBalancerClient,
+		api:      api,
 	}
 }
 
 func (lb *loadBalancerVirtualServer) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
-	setMeta(meta, lb.lbClient.Client)
+	lb.api.setMeta(meta)
 	var lbVSResp models.CreateLBVirtualServersReq
 
 	if err := tftags.Get(d, &lbVSResp); err != nil {
 		return err
 	}
 
-	getlbVirtualServerResp, err := lb.lbClient.GetSpecificLBVirtualServer(ctx, lbVSResp.LbID, lbVSResp.ID)
+	getlbVirtualServerResp, err := lb.api.GetLBVirtualServer(ctx, lbVSResp.LbID, lbVSResp.ID)
 	if err != nil {
+		return handleNotFound(d, err, "Load balancer virtual server")
+	}
+
+	virtualServer := getlbVirtualServerResp.LoadBalancerInstance
+	if err := tftags.Set(d, virtualServer.GetSpecificLBVirtualServersResp); err != nil {
 		return err
 	}
 
-	return tftags.Set(d, getlbVirtualServerResp.GetSpecificLBVirtualServersResp)
+	return setState(d, map[string]interface{}{
+		"name":        virtualServer.VipName,
+		"description": virtualServer.Description,
+		"vip_address": virtualServer.VipAddress,
+		"vip_port":    strconv.Itoa(virtualServer.VipPort),
+		"pool":        virtualServer.VSPool.ID,
+		"rule":        lbVirtualServerRulesToList(virtualServer.Config.Rules),
+	})
+}
+
+// Import load balancer virtual server with the ID in the format '<lb_id>/<virtual_server_id>'
+func (lb *loadBalancerVirtualServer) Import(ctx context.Context, d *utils.Data, meta interface{}) error {
+	return importChild(ctx, d, meta, "lb_id", lb)
 }
 
 func (lb *loadBalancerVirtualServer) Update(ctx context.Context, d *utils.Data, meta interface{}) error {
+	lb.api.setMeta(meta)
 	id := d.GetID()
 	updateReq := models.CreateLBVirtualServers{}
 	if err := tftags.Get(d, &updateReq.CreateLBVirtualServersReq); err != nil {
 		return err
 	}
+	var tfRules tfLBVirtualServerRules
+	if err := tftags.Get(d, &tfRules); err != nil {
+		return err
+	}
 
 	// align createReq and fill json related fields
 	if err := lb.virtualServerAlignRequest(&updateReq.CreateLBVirtualServersReq); err != nil {
@@ -52,12 +86,14 @@ func (lb *loadBalancerVirtualServer) Update(ctx context.Context, d *utils.Data,
 	}
 
 	retry := &utils.CustomRetry{
-		InitialDelay: time.Second * 15,
-		RetryDelay:   time.Second * 30,
+		InitialDelay: retryInitialDelay,
+		RetryDelay:   retryDelay,
+		Timeout:      d.Timeout(schema.TimeoutUpdate),
+		Cond:         utils.ErrorCond(maxErrCount, utils.AnyResponse),
 	}
 	_, err := retry.Retry(ctx, meta, func(ctx context.Context) (interface{}, error) {
-		return lb.lbClient.UpdateLBVirtualServers(ctx, updateReq,
-			updateReq.CreateLBVirtualServersReq.LbID, id)
+		return lb.api.UpdateLBVirtualServer(ctx, updateReq.CreateLBVirtualServersReq.LbID, id,
+			lbVirtualServerToRequest(updateReq.CreateLBVirtualServersReq, tfRules))
 	})
 	if err != nil {
 		return err
@@ -68,17 +104,23 @@ func (lb *loadBalancerVirtualServer) Update(ctx context.Context, d *utils.Data,
 
 func (lb *loadBalancerVirtualServer) Create(ctx context.Context, d *utils.Data, meta interface{}) error {
 	setMeta(meta, lb.lbClient.Client)
+	lb.api.setMeta(meta)
 	createReq := models.CreateLBVirtualServers{}
 	if err := tftags.Get(d, &createReq.CreateLBVirtualServersReq); err != nil {
 		return err
 	}
+	var tfRules tfLBVirtualServerRules
+	if err := tftags.Get(d, &tfRules); err != nil {
+		return err
+	}
 
 	// align createReq and fill json related fields
 	if err := lb.virtualServerAlignRequest(&createReq.CreateLBVirtualServersReq); err != nil {
 		return err
 	}
 
-	lbVirtualServersResp, err := lb.lbClient.CreateLBVirtualServers(ctx, createReq, createReq.CreateLBVirtualServersReq.LbID)
+	lbVirtualServersResp, err := lb.api.CreateLBVirtualServer(ctx, createReq.CreateLBVirtualServersReq.LbID,
+		lbVirtualServerToRequest(createReq.CreateLBVirtualServersReq, tfRules))
 	if err != nil {
 		return err
 	}
@@ -90,8 +132,9 @@ func (lb *loadBalancerVirtualServer) Create(ctx context.Context, d *utils.Data,
 
 	// wait until created
 	retry := &utils.CustomRetry{
-		InitialDelay: time.Second * 15,
-		RetryDelay:   time.Second * 30,
+		InitialDelay: retryInitialDelay,
+		RetryDelay:   retryDelay,
+		Timeout:      d.Timeout(schema.TimeoutCreate),
 	}
 	_, err = retry.Retry(ctx, meta, func(ctx context.Context) (interface{}, error) {
 		return lb.lbClient.GetSpecificLBVirtualServer(ctx, createReq.CreateLBVirtualServersReq.LbID,
@@ -149,3 +192,65 @@ func (lb *loadBalancerVirtualServer) virtualServerAlignRequest(createReq *models
 
 	return nil
 }
+
+// lbVirtualServerToRequest populates the virtual server request along with the rules
+func lbVirtualServerToRequest(
+	tfVirtualServer models.CreateLBVirtualServersReq,
+	tfRules tfLBVirtualServerRules,
+) lbVirtualServerRequest {
+	rules := []lbVirtualServerRule{}
+	if tfRules.Rules != nil {
+		rules = tfRules.Rules
+	}
+
+	return lbVirtualServerRequest{
+		LoadBalancerInstance: lbVirtualServerBody{
+			CreateLBVirtualServersReq: tfVirtualServer,
+			Config: lbVirtualServerConfig{
+				VirtualServerConfig: tfVirtualServer.VirtualServerConfig,
+				Rules:               rules,
+			},
+		},
+	}
+}
+
+func lbVirtualServerRulesToList(rules []lbVirtualServerRule) []map[string]interface{} {
+	list := make([]map[string]interface{}, 0, len(rules))
+	for _, r := range rules {
+		conditions := make([]map[string]interface{}, 0, len(r.MatchConditions))
+		for _, c := range r.MatchConditions {
+			conditions = append(conditions, map[string]interface{}{
+				"type":           c.Type,
+				"match_type":     c.MatchType,
+				"uri":            c.URI,
+				"header_name":    c.HeaderName,
+				"header_value":   c.HeaderValue,
+				"method":         c.Method,
+				"source_address": c.SourceAddress,
+				"case_sensitive": c.CaseSensitive,
+				"inverse":        c.Inverse,
+			})
+		}
+		actions := make([]map[string]interface{}, 0, len(r.Actions))
+		for _, a := range r.Actions {
+			actions = append(actions, map[string]interface{}{
+				"type":            a.Type,
+				"pool":            a.Pool,
+				"redirect_url":    a.RedirectURL,
+				"redirect_status": a.RedirectStatus,
+				"reply_status":    a.ReplyStatus,
+				"uri":             a.URI,
+				"header_name":     a.HeaderName,
+				"header_value":    a.HeaderValue,
+			})
+		}
+		list = append(list, map[string]interface{}{
+			"phase":          r.Phase,
+			"match_strategy": r.MatchStrategy,
+			"condition":      conditions,
+			"action":         actions,
+		})
+	}
+
+	return list
+}
@@ -5,11 +5,11 @@ package cmp
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
 	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tshihad/tftags"
 )
 
@@ -26,16 +26,29 @@ func newLoadBalancer(loadBalancerClient *client.LoadBalancerAPIService, routerCl
 }
 
 func (lb *loadBalancer) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
+	setMeta(meta, lb.lbClient.Client)
 	var loadBalancerResp models.GetSpecificNetworkLoadBalancerResp
 	if err := tftags.Get(d, &loadBalancerResp); err != nil {
 		return err
 	}
 	getResLoadBalancer, err := lb.lbClient.GetSpecificLoadBalancers(ctx, loadBalancerResp.ID)
 	if err != nil {
+		return handleNotFound(d, err, "Load balancer")
+	}
+
+	if err := tftags.Set(d, getResLoadBalancer.GetSpecificNetworkLoadBalancerResp); err != nil {
 		return err
 	}
 
-	return tftags.Set(d, getResLoadBalancer.GetSpecificNetworkLoadBalancerResp)
+	// populate configurable fields from the response, so that imported load
+	// balancer and changes made outside terraform will show up on plan
+	resLoadBalancer := getResLoadBalancer.GetSpecificNetworkLoadBalancerResp
+
+	return setState(d, map[string]interface{}{
+		"name":        resLoadBalancer.Name,
+		"description": resLoadBalancer.Description,
+		"enabled":     resLoadBalancer.Enabled,
+	})
 }
 
 func (lb *loadBalancer) Update(ctx context.Context, d *utils.Data, meta interface{}) error {
@@ -46,8 +59,10 @@ func (lb *loadBalancer) Update(ctx context.Context, d *utils.Data, meta interfac
 	}
 
 	retry := &utils.CustomRetry{
-		InitialDelay: time.Second * 15,
-		RetryDelay:   time.Second * 30,
+		InitialDelay: retryInitialDelay,
+		RetryDelay:   retryDelay,
+		Timeout:      d.Timeout(schema.TimeoutUpdate),
+		Cond:         utils.ErrorCond(maxErrCount, utils.AnyResponse),
 	}
 	_, err := retry.Retry(ctx, meta, func(ctx context.Context) (interface{}, error) {
 		return lb.lbClient.UpdateLoadBalancer(ctx, id, updateReq)
@@ -124,8 +139,9 @@ func (lb *loadBalancer) Create(ctx context.Context, d *utils.Data, meta interfac
 
 	// wait until created
 	retry := &utils.CustomRetry{
-		InitialDelay: time.Second * 15,
-		RetryDelay:   time.Second * 30,
+		InitialDelay: retryInitialDelay,
+		RetryDelay:   retryDelay,
+		Timeout:      d.Timeout(schema.TimeoutCreate),
 	}
 	_, err = retry.Retry(ctx, meta, func(ctx context.Context) (interface{}, error) {
 		return lb.lbClient.GetSpecificLoadBalancers(ctx, lbResp.NetworkLoadBalancerResp.ID)
@@ -5,42 +5,109 @@ package cmp
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
 	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tshihad/tftags"
 )
 
 type resNetwork struct {
 	nClient *client.NetworksAPIService
 	rClient *client.RouterAPIService
+	api     *apiService
 }
 
-func newResNetwork(nclient *client.NetworksAPIService, rclient *client.RouterAPIService) *resNetwork {
+func newResNetwork(nclient *client.NetworksAPIService, rclient *client.RouterAPIService, api *apiService) *resNetwork {
 	return &resNetwork{
 		nClient: nclient,
 		rClient: rclient,
+		api:     api,
 	}
 }
 
 func (r *resNetwork) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
-	setMeta(meta, r.rClient.Client)
-	// get network details
-	var tfNetwork models.GetSpecificNetwork
-	if err := tftags.Get(d, &tfNetwork); err != nil {
-		return err
-	}
-
+	r.api.setMeta(meta)
 	// Get network details with ID
-	getNetwork, err := r.nClient.GetSpecificNetwork(ctx, tfNetwork.ID)
+	resp, err := r.api.GetNetwork(ctx, d.GetID())
 	if err != nil {
-		return err
+		return handleNotFound(d, err, "Network")
 	}
 
-	return tftags.Set(d, getNetwork.Network)
+	// populate state from the response, so that imported networks and changes
+	// made outside terraform will show up on plan
+	network := resp.Network
+	values := map[string]interface{}{
+		"name":                       network.Name,
+		"description":                network.Description,
+		"display_name":               network.DisplayName,
+		"code":                       network.Code,
+		"type_id":                    network.Type.ID,
+		"external_id":                network.ExternalID,
+		"internal_id":                network.InternalID,
+		"unique_id":                  network.UniqueID,
+		"status":                     network.Status,
+		"gateway":                    network.Gateway,
+		"primary_dns":                network.DNSPrimary,
+		"secondary_dns":              network.DNSSecondary,
+		"cidr":                       network.Cidr,
+		"active":                     network.Active,
+		"scan_network":               network.ScanNetwork,
+		"dhcp_enabled":               network.DhcpServer,
+		"appliance_url_proxy_bypass": network.ApplianceURLProxyBypass,
+		"no_proxy":                   network.NoProxy,
+		"search_domains":             network.SearchDomains,
+		"allow_static_override":      network.AllowStaticOverride,
+		"domain_id":                  networkRefID(network.NetworkDomain),
+		"proxy_id":                   networkRefID(network.NetworkProxy),
+	}
+	// network without group is shared across all the groups
+	values["group_id"] = sharedGroupID
+	if network.Site != nil && network.Site.ID != "" {
+		values["group_id"] = network.Site.ID
+	}
+	if network.ScopeID != "" {
+		values["scope_id"] = network.ScopeID
+	}
+	// connected gateway and VLAN IDs are part of the config of NSX segments
+	values["connected_gateway"] = network.Config.ConnectedGateway
+	if network.Config.ConnectedGateway == "" {
+		values["connected_gateway"] = network.ConnectedGateway
+	}
+	values["vlan_ids"] = network.Config.VlanIDs
+	if network.Config.VlanIDs == "" {
+		values["vlan_ids"] = network.VlanIDs
+	}
+	if network.Config.SubnetIPManagementType != "" {
+		values["dhcp_network"] = []map[string]interface{}{{
+			"dhcp_type":           network.Config.SubnetIPManagementType,
+			"dhcp_server":         network.Config.SubnetIPServerID,
+			"dhcp_server_address": network.Config.SubnetDhcpServerAddress,
+			"dhcp_range":          network.Config.DhcpRange,
+			"dhcp_lease_time":     network.Config.SubnetDhcpLeaseTime,
+		}}
+	}
+	if poolID := networkRefID(network.Pool); poolID != 0 {
+		values["static_network"] = []map[string]interface{}{{
+			"pool_id": poolID,
+		}}
+	}
+
+	return setState(d, values)
+}
+
+// networkRefID returns the ID of the reference, 0 if the reference is not set
+func networkRefID(ref *networkRef) int {
+	if ref == nil {
+		return 0
+	}
+	id, _ := strconv.Atoi(ref.ID)
+
+	return id
 }
 
 func (r *resNetwork) Create(ctx context.Context, d *utils.Data, meta interface{}) error {
@@ -115,39 +182,18 @@ func (r *resNetwork) Create(ctx context.Context, d *utils.Data, meta interface{}
 	if err != nil {
 		return err
 	}
-	errCount := 0
 	cRetry := utils.CustomRetry{
-		Timeout:      time.Minute * 10,
-		RetryDelay:   time.Second * 10,
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Backoff:      utils.ExponentialBackoff(time.Second*10, maxRetryDelay),
 		InitialDelay: time.Second * 10,
-		Cond: func(response interface{}, err error) (bool, error) {
-			if err != nil {
-				errCount++
-				// return false as condition if same error returns 3 times.
-				if errCount == 3 {
-					return false, err
-				}
-
-				return false, nil
-			}
-
+		Cond: utils.PollErrorCond(maxErrCount, func(response interface{}) (bool, error) {
 			networkResponse, ok := response.(models.GetSpecificNetworkBody)
 			if !ok {
-				errCount++
-				if errCount == 3 {
-					return false, fmt.Errorf("%s", "error while getting Network")
-				}
-
-				return false, nil
-			}
-			errCount = 0
-
-			if strings.Contains(networkResponse.Network.ExternalID, utils.PortGroupPrefix) {
-				return true, nil
+				return false, fmt.Errorf("%s", "error while getting Network")
 			}
 
-			return false, nil
-		},
+			return strings.Contains(networkResponse.Network.ExternalID, utils.PortGroupPrefix), nil
+		}),
 	}
 
 	_, err = cRetry.Retry(ctx, meta, func(ctx context.Context) (interface{}, error) {
@@ -195,7 +241,7 @@ func (r *resNetwork) Delete(ctx context.Context, d *utils.Data, meta interface{}
 	retry := &utils.CustomRetry{
 		InitialDelay: time.Second * 10,
 		RetryDelay:   time.Second * 10,
-		Timeout:      time.Minute * 10,
+		Timeout:      d.Timeout(schema.TimeoutDelete),
 	}
 	resp, err := retry.Retry(ctx, meta, func(ctx context.Context) (interface{}, error) {
 		return r.nClient.DeleteNetwork(ctx, networkID)
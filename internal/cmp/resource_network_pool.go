@@ -0,0 +1,125 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package cmp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/tshihad/tftags"
+)
+
+// tfNetworkPool is the terraform model for hpegl_vmaas_network_pool resource
+type tfNetworkPool struct {
+	ID         int                  `tf:"id,computed"`
+	Name       string               `tf:"name"`
+	IPRanges   []networkPoolIPRange `tf:"ip_range"`
+	Gateway    string               `tf:"gateway"`
+	Netmask    string               `tf:"netmask"`
+	DNSServers []string             `tf:"dns_servers"`
+}
+
+// resNetworkPool implements functions related to IP pools managed by CMP
+type resNetworkPool struct {
+	api *apiService
+}
+
+func newResNetworkPool(api *apiService) *resNetworkPool {
+	return &resNetworkPool{
+		api: api,
+	}
+}
+
+func (n *resNetworkPool) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
+	n.api.setMeta(meta)
+	var tfPool tfNetworkPool
+	if err := tftags.Get(d, &tfPool); err != nil {
+		return err
+	}
+
+	resp, err := n.api.GetNetworkPool(ctx, tfPool.ID)
+	if err != nil {
+		return handleNotFound(d, err, "Network pool")
+	}
+	pool := resp.NetworkPool
+
+	ipRanges := make([]map[string]interface{}, 0, len(pool.IPRanges))
+	for _, r := range pool.IPRanges {
+		ipRanges = append(ipRanges, map[string]interface{}{
+			"start_address": r.StartAddress,
+			"end_address":   r.EndAddress,
+		})
+	}
+
+	return setState(d, map[string]interface{}{
+		"name":        pool.Name,
+		"ip_range":    ipRanges,
+		"gateway":     pool.Gateway,
+		"netmask":     pool.Netmask,
+		"dns_servers": pool.DNSServers,
+	})
+}
+
+func (n *resNetworkPool) Create(ctx context.Context, d *utils.Data, meta interface{}) error {
+	n.api.setMeta(meta)
+	var tfPool tfNetworkPool
+	if err := tftags.Get(d, &tfPool); err != nil {
+		return err
+	}
+
+	resp, err := n.api.CreateNetworkPool(ctx, networkPoolToRequest(tfPool))
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "creating network pool")
+	}
+	tfPool.ID = resp.ID
+
+	return tftags.Set(d, tfPool)
+}
+
+func (n *resNetworkPool) Update(ctx context.Context, d *utils.Data, meta interface{}) error {
+	n.api.setMeta(meta)
+	var tfPool tfNetworkPool
+	if err := tftags.Get(d, &tfPool); err != nil {
+		return err
+	}
+
+	resp, err := n.api.UpdateNetworkPool(ctx, tfPool.ID, networkPoolToRequest(tfPool))
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "updating network pool")
+	}
+
+	return nil
+}
+
+func (n *resNetworkPool) Delete(ctx context.Context, d *utils.Data, meta interface{}) error {
+	n.api.setMeta(meta)
+	resp, err := n.api.DeleteNetworkPool(ctx, d.GetID())
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "deleting network pool")
+	}
+
+	return nil
+}
+
+func networkPoolToRequest(tfPool tfNetworkPool) networkPoolRequest {
+	return networkPoolRequest{
+		NetworkPool: networkPoolBody{
+			Name:       tfPool.Name,
+			Type:       networkPoolType{Code: networkPoolTypeCode},
+			Gateway:    tfPool.Gateway,
+			Netmask:    tfPool.Netmask,
+			DNSServers: tfPool.DNSServers,
+			IPRanges:   tfPool.IPRanges,
+		},
+	}
+}
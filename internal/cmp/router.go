@@ -5,34 +5,61 @@ package cmp
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
 	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tshihad/tftags"
 )
 
 type router struct {
 	rClient *client.RouterAPIService
+	api     *apiService
 }
 
-func newRouter(routerClient *client.RouterAPIService) *router {
+func newRouter(routerClient *client.RouterAPIService, api *apiService) *router {
 	return &router{
 		rClient: routerClient,
+		api:     api,
 	}
 }
 
 func (r *router) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
-	var tfRouter models.GetNetworkRouter
-	if err := tftags.Get(d, &tfRouter); err != nil {
-		return err
-	}
-	getRouter, err := r.rClient.GetSpecificRouter(ctx, tfRouter.ID)
+	r.api.setMeta(meta)
+	getRouter, err := r.api.GetRouter(ctx, d.GetID())
 	if err != nil {
+		return handleNotFound(d, err, "Router")
+	}
+
+	networkRouter := getRouter.NetworkRouter
+	if err := tftags.Set(d, networkRouter.GetNetworkRouter); err != nil {
 		return err
 	}
 
-	return tftags.Set(d, getRouter.NetworkRouter)
+	// populate configurable fields from the response, so that imported router
+	// and changes made outside terraform will show up on plan
+	values := map[string]interface{}{
+		"name":              networkRouter.Name,
+		"enable":            networkRouter.Enabled,
+		"type_id":           networkRouter.Type.ID,
+		"network_server_id": networkRouter.NetworkServer.ID,
+	}
+	if networkRouter.Site != nil && networkRouter.Site.ID != "" {
+		values["group_id"] = networkRouter.Site.ID
+	} else if d.GetString("group_id") == "" {
+		// router without group is shared across all the groups
+		values["group_id"] = sharedGroupID
+	}
+	switch {
+	case routerIsTier0(networkRouter.Type):
+		values["tier0_config"] = routerTier0Config(networkRouter)
+	case routerIsTier1(networkRouter.Type):
+		values["tier1_config"] = routerTier1Config(networkRouter)
+	}
+
+	return setState(d, values)
 }
 
 func (r *router) Create(ctx context.Context, d *utils.Data, meta interface{}) error {
@@ -55,11 +82,12 @@ func (r *router) Create(ctx context.Context, d *utils.Data, meta interface{}) er
 
 	// wait until created
 	retry := &utils.CustomRetry{
-		RetryDelay:   1,
-		InitialDelay: 1,
-		Cond: func(response interface{}, ResponseErr error) (bool, error) {
+		InitialDelay: retryInitialDelay,
+		RetryDelay:   retryDelay,
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Cond: utils.PollErrorCond(maxErrCount, func(response interface{}) (bool, error) {
 			return response.(models.GetSpecificRouterResp).NetworkRouter.Status == "ok", nil
-		},
+		}),
 	}
 	_, err = retry.Retry(ctx, meta, func(ctx context.Context) (interface{}, error) {
 		return r.rClient.GetSpecificRouter(ctx, routerResp.ID)
@@ -84,7 +112,13 @@ func (r *router) Update(ctx context.Context, d *utils.Data, meta interface{}) er
 	// HaMode cannot be updated, setting it to empty so that it is ignored in the API Payload.
 	createReq.NetworkRouter.Config.HaMode = ""
 
-	resp, err := utils.Retry(ctx, meta, func(ctx context.Context) (interface{}, error) {
+	retry := &utils.CustomRetry{
+		InitialDelay: retryInitialDelay,
+		RetryDelay:   retryDelay,
+		Timeout:      d.Timeout(schema.TimeoutUpdate),
+		Cond:         utils.ErrorCond(maxErrCount, utils.AnyResponse),
+	}
+	resp, err := retry.Retry(ctx, meta, func(ctx context.Context) (interface{}, error) {
 		return r.rClient.UpdateRouter(ctx, createReq.NetworkRouter.ID, createReq)
 	})
 	if err != nil {
@@ -110,6 +144,77 @@ func (r *router) Delete(ctx context.Context, d *utils.Data, meta interface{}) er
 	return nil
 }
 
+func routerIsTier0(t routerType) bool {
+	return strings.HasSuffix(strings.ToLower(t.Code), "tier0") || strings.HasSuffix(t.Name, tier0GatewayType)
+}
+
+func routerIsTier1(t routerType) bool {
+	return strings.HasSuffix(strings.ToLower(t.Code), "tier1") || strings.HasSuffix(t.Name, tier1GatewayType)
+}
+
+// routerTier0Config returns tier0_config from the gateway config of the router
+func routerTier0Config(networkRouter routerBody) []map[string]interface{} {
+	config := networkRouter.Config
+	routeAdvertisement := config.RouteAdvertisement
+
+	return []map[string]interface{}{{
+		"edge_cluster": config.EdgeCluster,
+		"ha_mode":      config.HaMode,
+		"fail_over":    config.FailOver,
+		"bgp": []map[string]interface{}{{
+			"local_as_num":     config.LOCALASNUM,
+			"ecmp":             config.ECMP,
+			"multipath_relax":  config.MULTIPATHRELAX,
+			"inter_sr_ibgp":    config.INTERSRIBGP,
+			"restart_mode":     config.RESTARTMODE,
+			"restart_time":     config.RESTARTTIME,
+			"stale_route_time": config.STALEROUTETIME,
+			"enable_bgp":       networkRouter.EnableBGP,
+		}},
+		"route_redistribution_tier0": []map[string]interface{}{{
+			"tier0_static":             config.TIER0STATIC,
+			"tier0_nat":                config.TIER0NAT,
+			"tier0_ipsec_local_ip":     config.TIER0IPSECLOCALIP,
+			"tier0_dns_forwarder_ip":   config.TIER0DNSFORWARDERIP,
+			"tier0_service_interface":  config.TIER0SERVICEINTERFACE,
+			"tier0_external_interface": config.TIER0EXTERNALINTERFACE,
+			"tier0_loopback_interface": config.TIER0LOOPBACKINTERFACE,
+			"tier0_segment":            config.TIER0SEGMENT,
+		}},
+		"route_redistribution_tier1": []map[string]interface{}{{
+			"tier1_dns_forwarder_ip":     routeAdvertisement.TIER1DNSFORWARDERIP,
+			"tier1_static":               routeAdvertisement.TIER1STATIC,
+			"tier1_lb_vip":               routeAdvertisement.TIER1LBVIP,
+			"tier1_nat":                  routeAdvertisement.TIER1NAT,
+			"tier1_lb_snat":              routeAdvertisement.TIER1LBSNAT,
+			"tier1_ipsec_local_endpoint": routeAdvertisement.TIER1IPSECLOCALENDPOINT,
+			"tier1_service_interface":    config.TIER1SERVICEINTERFACE,
+			"tier1_segment":              config.TIER1SEGMENT,
+		}},
+	}}
+}
+
+// routerTier1Config returns tier1_config from the gateway config of the router
+func routerTier1Config(networkRouter routerBody) []map[string]interface{} {
+	config := networkRouter.Config
+	routeAdvertisement := config.RouteAdvertisement
+
+	return []map[string]interface{}{{
+		"tier0_gateway": config.Tier0Gateways,
+		"edge_cluster":  config.EdgeCluster,
+		"fail_over":     config.FailOver,
+		"route_advertisement": []map[string]interface{}{{
+			"tier1_connected":            routeAdvertisement.Tier1Connected,
+			"tier1_nat":                  routeAdvertisement.TIER1NAT,
+			"tier1_static_routes":        routeAdvertisement.Tier1StaticRoutes,
+			"tier1_lb_vip":               routeAdvertisement.TIER1LBVIP,
+			"tier1_lb_snat":              routeAdvertisement.TIER1LBSNAT,
+			"tier1_dns_forwarder_ip":     routeAdvertisement.TIER1DNSFORWARDERIP,
+			"tier1_ipsec_local_endpoint": routeAdvertisement.TIER1IPSECLOCALENDPOINT,
+		}},
+	}}
+}
+
 func (r *router) routerAlignRouterRequest(ctx context.Context, meta interface{}, routerReq *models.CreateRouterRequest) error {
 	nsxType, err := GetNsxTypeFromCMP(ctx, r.rClient.Client)
 	if err != nil {
@@ -14,27 +14,64 @@ import (
 
 type routerBgpNeighbor struct {
 	rClient *client.RouterAPIService
+	api     *apiService
 }
 
-func newRouterBgpNeighbor(routerBgpNeighborClient *client.RouterAPIService) *routerBgpNeighbor {
+func newRouterBgpNeighbor(routerBgpNeighborClient *client.RouterAPIService, api *apiService) *routerBgpNeighbor {
 	return &routerBgpNeighbor{
 		rClient: routerBgpNeighborClient,
+		api:     api,
 	}
 }
 
 func (r *routerBgpNeighbor) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
-	setMeta(meta, r.rClient.Client)
+	r.api.setMeta(meta)
 	var tfBgpNeighbor models.CreateRouterRequestBgpNeighborBody
 	if err := tftags.Get(d, &tfBgpNeighbor); err != nil {
 		return err
 	}
 
-	_, err := r.rClient.GetSpecificRouterBgpNeighbor(ctx, tfBgpNeighbor.RouterID, tfBgpNeighbor.ID)
+	resp, err := r.api.GetRouterBgpNeighbor(ctx, tfBgpNeighbor.RouterID, tfBgpNeighbor.ID)
 	if err != nil {
-		return err
+		return handleNotFound(d, err, "Router BGP neighbor")
 	}
 
-	return tftags.Set(d, tfBgpNeighbor)
+	// populate state from the response, so that changes made outside
+	// terraform will show up on plan
+	neighbor := resp.NetworkRouterBgpNeighbor
+	values := map[string]interface{}{
+		"ip_address":            neighbor.IPAddress,
+		"keepalive":             neighbor.KeepAlive,
+		"holddown":              neighbor.HoldDown,
+		"router_filtering_type": neighbor.RouteFilteringType,
+		"router_filtering_in":   neighbor.RouteFilteringIn,
+		"router_filtering_out":  neighbor.RouteFilteringOut,
+		"bfd_enabled":           neighbor.BfdEnabled,
+		"bfd_interval":          neighbor.BfdInterval,
+		"bfd_multiple":          neighbor.BfdMultiple,
+		"allow_as_in":           neighbor.AllowAsIn,
+		"hop_limit":             neighbor.HopLimit,
+		"restart_mode":          neighbor.RestartMode,
+	}
+	if neighbor.RemoteAs != "" {
+		remoteAs, err := neighbor.RemoteAs.Int64()
+		if err != nil {
+			return fmt.Errorf("error while parsing remote AS %q of BGP neighbor: %w", neighbor.RemoteAs, err)
+		}
+		values["remote_as"] = int(remoteAs)
+	}
+	if len(neighbor.Config.SourceAddresses) > 0 {
+		values["config"] = []map[string]interface{}{{
+			"source_addresses": neighbor.Config.SourceAddresses,
+		}}
+	}
+
+	return setState(d, values)
+}
+
+// Import BGP neighbor with the ID in the format '<router_id>/<bgp_neighbor_id>'
+func (r *routerBgpNeighbor) Import(ctx context.Context, d *utils.Data, meta interface{}) error {
+	return importChild(ctx, d, meta, "router_id", r)
 }
 
 func (r *routerBgpNeighbor) Create(ctx context.Context, d *utils.Data, meta interface{}) error {
@@ -74,9 +111,8 @@ func (r *routerBgpNeighbor) Update(ctx context.Context, d *utils.Data, meta inte
 	}
 
 	if !bgpNeighborRes.Success {
-		return fmt.Errorf(successErr, "creating BGPNEIGHBOR rule for the router")
+		return fmt.Errorf(successErr, "updating BGPNEIGHBOR rule for the router")
 	}
-	tfBgpNeighbor.ID = bgpNeighborRes.ID
 
 	return tftags.Set(d, tfBgpNeighbor)
 }
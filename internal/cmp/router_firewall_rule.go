@@ -0,0 +1,155 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package cmp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/tshihad/tftags"
+)
+
+// tfRouterFirewallRule is the terraform model for hpegl_vmaas_router_firewall_rule
+type tfRouterFirewallRule struct {
+	ID           int      `tf:"id,computed"`
+	RouterID     int      `tf:"router_id"`
+	RuleGroupID  int      `tf:"rule_group_id"`
+	Name         string   `tf:"name"`
+	Description  string   `tf:"description"`
+	Enabled      bool     `tf:"enabled"`
+	Priority     int      `tf:"priority"`
+	Action       string   `tf:"action"`
+	Direction    string   `tf:"direction"`
+	Sources      []string `tf:"sources"`
+	Destinations []string `tf:"destinations"`
+	Services     []string `tf:"services"`
+	AppliedTo    []string `tf:"applied_to"`
+	Logging      bool     `tf:"logging"`
+}
+
+// routerFirewallRule implements functions related to gateway firewall rules
+// of a router firewall rule group
+type routerFirewallRule struct {
+	api *apiService
+}
+
+func newRouterFirewallRule(api *apiService) *routerFirewallRule {
+	return &routerFirewallRule{
+		api: api,
+	}
+}
+
+func (r *routerFirewallRule) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
+	r.api.setMeta(meta)
+	var tfRule tfRouterFirewallRule
+	if err := tftags.Get(d, &tfRule); err != nil {
+		return err
+	}
+
+	resp, err := r.api.GetRouterFirewallRule(ctx, tfRule.RouterID, tfRule.ID)
+	if err != nil {
+		return handleNotFound(d, err, "Router firewall rule")
+	}
+	rule := resp.Rule
+	values := map[string]interface{}{
+		"name":         rule.Name,
+		"description":  rule.Description,
+		"enabled":      rule.Enabled,
+		"priority":     rule.Priority,
+		"action":       rule.Action,
+		"direction":    rule.Direction,
+		"sources":      rule.Config.Sources,
+		"destinations": rule.Config.Destinations,
+		"services":     rule.Config.Services,
+		"applied_to":   rule.Config.Scope,
+		"logging":      rule.Config.Logging,
+	}
+	if rule.RuleGroup.ID != 0 {
+		values["rule_group_id"] = rule.RuleGroup.ID
+	}
+
+	return setState(d, values)
+}
+
+// Import firewall rule with the ID in the format '<router_id>/<rule_id>'
+func (r *routerFirewallRule) Import(ctx context.Context, d *utils.Data, meta interface{}) error {
+	return importChild(ctx, d, meta, "router_id", r)
+}
+
+func (r *routerFirewallRule) Create(ctx context.Context, d *utils.Data, meta interface{}) error {
+	r.api.setMeta(meta)
+	var tfRule tfRouterFirewallRule
+	if err := tftags.Get(d, &tfRule); err != nil {
+		return err
+	}
+
+	resp, err := r.api.CreateRouterFirewallRule(ctx, tfRule.RouterID, routerFirewallRuleToRequest(tfRule))
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "creating firewall rule for the router")
+	}
+	tfRule.ID = resp.ID
+
+	return tftags.Set(d, tfRule)
+}
+
+func (r *routerFirewallRule) Update(ctx context.Context, d *utils.Data, meta interface{}) error {
+	r.api.setMeta(meta)
+	var tfRule tfRouterFirewallRule
+	if err := tftags.Get(d, &tfRule); err != nil {
+		return err
+	}
+
+	resp, err := r.api.UpdateRouterFirewallRule(ctx, tfRule.RouterID, tfRule.ID, routerFirewallRuleToRequest(tfRule))
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "updating firewall rule for the router")
+	}
+
+	return nil
+}
+
+func (r *routerFirewallRule) Delete(ctx context.Context, d *utils.Data, meta interface{}) error {
+	r.api.setMeta(meta)
+	var tfRule tfRouterFirewallRule
+	if err := tftags.Get(d, &tfRule); err != nil {
+		return err
+	}
+
+	resp, err := r.api.DeleteRouterFirewallRule(ctx, tfRule.RouterID, tfRule.ID)
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "deleting firewall rule for the router")
+	}
+
+	return nil
+}
+
+func routerFirewallRuleToRequest(tfRule tfRouterFirewallRule) routerFirewallRuleRequest {
+	return routerFirewallRuleRequest{
+		Rule: routerFirewallRuleBody{
+			Name:        tfRule.Name,
+			Description: tfRule.Description,
+			Enabled:     tfRule.Enabled,
+			Priority:    tfRule.Priority,
+			Action:      tfRule.Action,
+			Direction:   tfRule.Direction,
+			RuleGroup:   models.IDModel{ID: tfRule.RuleGroupID},
+			Config: routerFirewallRuleConfig{
+				Sources:      tfRule.Sources,
+				Destinations: tfRule.Destinations,
+				Services:     tfRule.Services,
+				Scope:        tfRule.AppliedTo,
+				Logging:      tfRule.Logging,
+			},
+		},
+	}
+}
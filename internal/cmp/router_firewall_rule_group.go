@@ -14,11 +14,13 @@ import (
 
 type routerFirewallRuleGroup struct {
 	rClient *client.RouterAPIService
+	api     *apiService
 }
 
-func newRouterFirewallRuleGroup(rClient *client.RouterAPIService) *routerFirewallRuleGroup {
+func newRouterFirewallRuleGroup(rClient *client.RouterAPIService, api *apiService) *routerFirewallRuleGroup {
 	return &routerFirewallRuleGroup{
 		rClient: rClient,
+		api:     api,
 	}
 }
 
@@ -29,13 +31,27 @@ func (r *routerFirewallRuleGroup) Read(ctx context.Context, d *utils.Data, meta
 		return err
 	}
 
-	_, err := r.rClient.GetSpecificRouterFirewallRuleGroup(ctx, tfModel.RouterID,
+	resp, err := r.rClient.GetSpecificRouterFirewallRuleGroup(ctx, tfModel.RouterID,
 		tfModel.ID)
 	if err != nil {
-		return err
+		return handleNotFound(d, err, "Router firewall rule group")
 	}
 
-	return tftags.Set(d, tfModel)
+	// populate state from the response, so that changes made outside
+	// terraform will show up on plan
+	group := resp.GetSpecificRouterFirewallRuleGroup
+
+	return setState(d, map[string]interface{}{
+		"name":        group.Name,
+		"description": group.Description,
+		"priority":    group.Priority,
+		"group_layer": group.GroupLayer,
+	})
+}
+
+// Import firewall rule group with the ID in the format '<router_id>/<rule_group_id>'
+func (r *routerFirewallRuleGroup) Import(ctx context.Context, d *utils.Data, meta interface{}) error {
+	return importChild(ctx, d, meta, "router_id", r)
 }
 
 func (r *routerFirewallRuleGroup) Create(ctx context.Context, d *utils.Data, meta interface{}) error {
@@ -63,7 +79,22 @@ func (r *routerFirewallRuleGroup) Create(ctx context.Context, d *utils.Data, met
 }
 
 func (r *routerFirewallRuleGroup) Update(ctx context.Context, d *utils.Data, meta interface{}) error {
-	// to be implemented
+	r.api.setMeta(meta)
+	var tfModel models.CreateRouterFirewallRuleGroup
+	if err := tftags.Get(d, &tfModel); err != nil {
+		return err
+	}
+	tfModel.ExternalType = routerFirewallExternalPolicy
+	resp, err := r.api.UpdateRouterFirewallRuleGroup(ctx, tfModel.RouterID, tfModel.ID,
+		models.CreateRouterFirewallRuleGroupRequest{CreateRouterFirewallRuleGroup: tfModel},
+	)
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "updating firewall rule group for the router")
+	}
+
 	return nil
 }
 
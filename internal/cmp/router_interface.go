@@ -0,0 +1,181 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package cmp
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/tshihad/tftags"
+)
+
+// tier0ProviderPath is part of the provider ID of NSX-T tier-0 gateways
+const tier0ProviderPath = "/tier-0s/"
+
+// tfRouterInterface is the terraform model for hpegl_vmaas_router_interface
+type tfRouterInterface struct {
+	ID          int    `tf:"id,computed"`
+	RouterID    int    `tf:"router_id"`
+	Name        string `tf:"name"`
+	Description string `tf:"description"`
+	Type        string `tf:"type"`
+	EdgeNode    string `tf:"edge_node"`
+	SegmentID   int    `tf:"segment_id"`
+	Cidr        string `tf:"cidr"`
+	Mtu         int    `tf:"mtu"`
+	UrpfMode    string `tf:"urpf_mode"`
+	ProviderID  string `tf:"provider_id,computed"`
+}
+
+// routerInterface implements functions related to interfaces of tier-0 gateways
+type routerInterface struct {
+	rClient *client.RouterAPIService
+	api     *apiService
+}
+
+func newRouterInterface(routerClient *client.RouterAPIService, api *apiService) *routerInterface {
+	return &routerInterface{
+		rClient: routerClient,
+		api:     api,
+	}
+}
+
+func (r *routerInterface) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
+	r.api.setMeta(meta)
+	var tfInterface tfRouterInterface
+	if err := tftags.Get(d, &tfInterface); err != nil {
+		return err
+	}
+
+	resp, err := r.api.GetRouterInterface(ctx, tfInterface.RouterID, tfInterface.ID)
+	if err != nil {
+		return handleNotFound(d, err, "Router interface")
+	}
+	routerInterface := resp.NetworkRouterInterface
+	values := map[string]interface{}{
+		"name":        routerInterface.Name,
+		"description": routerInterface.Description,
+		"type":        routerInterface.InterfaceType,
+		"edge_node":   routerInterface.Config.EdgeNode,
+		"cidr":        routerInterface.Cidr,
+		"urpf_mode":   routerInterface.Config.UrpfMode,
+		"provider_id": routerInterface.ProviderID,
+	}
+	if routerInterface.Network != nil {
+		values["segment_id"] = routerInterface.Network.ID
+	}
+	if routerInterface.Config.Mtu != 0 {
+		values["mtu"] = routerInterface.Config.Mtu
+	}
+
+	return setState(d, values)
+}
+
+// Import router interface with the ID in the format '<router_id>/<interface_id>'
+func (r *routerInterface) Import(ctx context.Context, d *utils.Data, meta interface{}) error {
+	return importChild(ctx, d, meta, "router_id", r)
+}
+
+func (r *routerInterface) Create(ctx context.Context, d *utils.Data, meta interface{}) error {
+	setMeta(meta, r.rClient.Client)
+	r.api.setMeta(meta)
+	var tfInterface tfRouterInterface
+	if err := tftags.Get(d, &tfInterface); err != nil {
+		return err
+	}
+
+	// interfaces are supported only on tier-0 gateways
+	routerResp, err := r.rClient.GetSpecificRouter(ctx, tfInterface.RouterID)
+	if err != nil {
+		return err
+	}
+	if !strings.Contains(routerResp.NetworkRouter.ProviderID, tier0ProviderPath) {
+		return fmt.Errorf("interfaces are supported only on %s, router %d is not a %s",
+			tier0GatewayType, tfInterface.RouterID, tier0GatewayType)
+	}
+
+	req, err := routerInterfaceToRequest(tfInterface)
+	if err != nil {
+		return err
+	}
+	resp, err := r.api.CreateRouterInterface(ctx, tfInterface.RouterID, req)
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "creating interface for the router")
+	}
+	tfInterface.ID = resp.ID
+
+	return tftags.Set(d, tfInterface)
+}
+
+func (r *routerInterface) Update(ctx context.Context, d *utils.Data, meta interface{}) error {
+	r.api.setMeta(meta)
+	var tfInterface tfRouterInterface
+	if err := tftags.Get(d, &tfInterface); err != nil {
+		return err
+	}
+
+	req, err := routerInterfaceToRequest(tfInterface)
+	if err != nil {
+		return err
+	}
+	resp, err := r.api.UpdateRouterInterface(ctx, tfInterface.RouterID, tfInterface.ID, req)
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "updating interface for the router")
+	}
+
+	return nil
+}
+
+func (r *routerInterface) Delete(ctx context.Context, d *utils.Data, meta interface{}) error {
+	r.api.setMeta(meta)
+	var tfInterface tfRouterInterface
+	if err := tftags.Get(d, &tfInterface); err != nil {
+		return err
+	}
+
+	resp, err := r.api.DeleteRouterInterface(ctx, tfInterface.RouterID, tfInterface.ID)
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "deleting interface for the router")
+	}
+
+	return nil
+}
+
+func routerInterfaceToRequest(tfInterface tfRouterInterface) (routerInterfaceRequest, error) {
+	ip, _, err := net.ParseCIDR(tfInterface.Cidr)
+	if err != nil {
+		return routerInterfaceRequest{}, err
+	}
+
+	body := routerInterfaceBody{
+		Name:          tfInterface.Name,
+		Description:   tfInterface.Description,
+		InterfaceType: tfInterface.Type,
+		IPAddress:     ip.String(),
+		Cidr:          tfInterface.Cidr,
+		Config: routerInterfaceConfig{
+			EdgeNode: tfInterface.EdgeNode,
+			Mtu:      tfInterface.Mtu,
+			UrpfMode: tfInterface.UrpfMode,
+		},
+	}
+	if tfInterface.SegmentID != 0 {
+		body.Network = &models.IDModel{ID: tfInterface.SegmentID}
+	}
+
+	return routerInterfaceRequest{NetworkRouterInterface: body}, nil
+}
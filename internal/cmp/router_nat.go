@@ -14,27 +14,56 @@ import (
 
 type routerNat struct {
 	rClient *client.RouterAPIService
+	api     *apiService
 }
 
-func newRouterNat(routerNatClient *client.RouterAPIService) *routerNat {
+func newRouterNat(routerNatClient *client.RouterAPIService, api *apiService) *routerNat {
 	return &routerNat{
 		rClient: routerNatClient,
+		api:     api,
 	}
 }
 
 func (r *routerNat) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
-	setMeta(meta, r.rClient.Client)
+	r.api.setMeta(meta)
 	var tfNat models.CreateRouterNat
 	if err := tftags.Get(d, &tfNat); err != nil {
 		return err
 	}
 
-	_, err := r.rClient.GetSpecificRouterNat(ctx, tfNat.RouterID, tfNat.ID)
+	resp, err := r.api.GetRouterNat(ctx, tfNat.RouterID, tfNat.ID)
 	if err != nil {
-		return err
+		return handleNotFound(d, err, "Router NAT")
 	}
 
-	return tftags.Set(d, tfNat)
+	// populate state from the response, so that changes made outside
+	// terraform will show up on plan
+	nat := resp.NetworkRouterNAT
+	values := map[string]interface{}{
+		"name":                nat.Name,
+		"description":         nat.Description,
+		"enabled":             nat.Enabled,
+		"source_network":      nat.SourceNetwork,
+		"destination_network": nat.DestinationNetwork,
+		"translated_network":  nat.TranslatedNetwork,
+		"priority":            nat.Priority,
+		"translated_ports":    nat.TranslatedPorts,
+	}
+	if nat.Config.Action != "" {
+		values["config"] = []map[string]interface{}{{
+			"action":   nat.Config.Action,
+			"service":  nat.Config.Service,
+			"firewall": nat.Config.Firewall,
+			"logging":  nat.Config.Logging,
+		}}
+	}
+
+	return setState(d, values)
+}
+
+// Import NAT rule with the ID in the format '<router_id>/<nat_id>'
+func (r *routerNat) Import(ctx context.Context, d *utils.Data, meta interface{}) error {
+	return importChild(ctx, d, meta, "router_id", r)
 }
 
 func (r *routerNat) Create(ctx context.Context, d *utils.Data, meta interface{}) error {
@@ -74,9 +103,8 @@ func (r *routerNat) Update(ctx context.Context, d *utils.Data, meta interface{})
 	}
 
 	if !natRes.Success {
-		return fmt.Errorf(successErr, "creating NAT rule for the router")
+		return fmt.Errorf(successErr, "updating NAT rule for the router")
 	}
-	tfNat.ID = natRes.ID
 
 	return tftags.Set(d, tfNat)
 }
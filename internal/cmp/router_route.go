@@ -14,11 +14,13 @@ import (
 
 type routerRoute struct {
 	rClient *client.RouterAPIService
+	api     *apiService
 }
 
-func newRouterRoute(routeClient *client.RouterAPIService) *routerRoute {
+func newRouterRoute(routeClient *client.RouterAPIService, api *apiService) *routerRoute {
 	return &routerRoute{
 		rClient: routeClient,
+		api:     api,
 	}
 }
 
@@ -30,10 +32,31 @@ func (r *routerRoute) Read(ctx context.Context, d *utils.Data, meta interface{})
 	}
 	resp, err := r.rClient.GetSpecificRouterRoute(ctx, tfRoute.RouterID, tfRoute.ID)
 	if err != nil {
+		return handleNotFound(d, err, "Router route")
+	}
+
+	// populate configurable fields from the response, so that changes made
+	// outside terraform will show up on plan
+	route := resp.NetworkRoute
+	if err := setState(d, map[string]interface{}{
+		"name":          route.Name,
+		"description":   route.Description,
+		"enabled":       route.Enabled,
+		"default_route": route.DefaultRoute,
+		"network":       route.Source,
+		"next_hop":      route.Destination,
+		"mtu":           route.NetworkMtu,
+		"priority":      route.Priority,
+	}); err != nil {
 		return err
 	}
 
-	return tftags.Set(d, resp.NetworkRoute)
+	return tftags.Set(d, route)
+}
+
+// Import route with the ID in the format '<router_id>/<route_id>'
+func (r *routerRoute) Import(ctx context.Context, d *utils.Data, meta interface{}) error {
+	return importChild(ctx, d, meta, "router_id", r)
 }
 
 func (r *routerRoute) Create(ctx context.Context, d *utils.Data, meta interface{}) error {
@@ -60,6 +83,24 @@ func (r *routerRoute) Create(ctx context.Context, d *utils.Data, meta interface{
 }
 
 func (r *routerRoute) Update(ctx context.Context, d *utils.Data, meta interface{}) error {
+	r.api.setMeta(meta)
+	var tfRoute models.RouterRouteBody
+	if err := tftags.Get(d, &tfRoute); err != nil {
+		return err
+	}
+
+	resp, err := r.api.UpdateRouterRoute(ctx, tfRoute.RouterID, tfRoute.ID,
+		models.CreateRouterRoute{
+			NetworkRoute: tfRoute,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "updating route for the router")
+	}
+
 	return nil
 }
 
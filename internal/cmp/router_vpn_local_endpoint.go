@@ -0,0 +1,136 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package cmp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/tshihad/tftags"
+)
+
+// tfRouterVpnLocalEndpoint is the terraform model for hpegl_vmaas_router_vpn_local_endpoint
+type tfRouterVpnLocalEndpoint struct {
+	ID           int    `tf:"id,computed"`
+	RouterID     int    `tf:"router_id"`
+	VpnServiceID int    `tf:"vpn_service_id"`
+	Name         string `tf:"name"`
+	Description  string `tf:"description"`
+	LocalAddress string `tf:"local_address"`
+	LocalID      string `tf:"local_id"`
+	ProviderID   string `tf:"provider_id,computed"`
+}
+
+// routerVpnLocalEndpoint implements functions related to local endpoints of
+// IPsec VPN service of a router
+type routerVpnLocalEndpoint struct {
+	api *apiService
+}
+
+func newRouterVpnLocalEndpoint(api *apiService) *routerVpnLocalEndpoint {
+	return &routerVpnLocalEndpoint{
+		api: api,
+	}
+}
+
+func (r *routerVpnLocalEndpoint) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
+	r.api.setMeta(meta)
+	var tfEndpoint tfRouterVpnLocalEndpoint
+	if err := tftags.Get(d, &tfEndpoint); err != nil {
+		return err
+	}
+
+	resp, err := r.api.GetRouterVpnLocalEndpoint(ctx, tfEndpoint.RouterID, tfEndpoint.ID)
+	if err != nil {
+		return handleNotFound(d, err, "Router VPN local endpoint")
+	}
+	endpoint := resp.LocalEndpoint
+	values := map[string]interface{}{
+		"name":          endpoint.Name,
+		"description":   endpoint.Description,
+		"local_address": endpoint.LocalAddress,
+		"local_id":      endpoint.LocalID,
+		"provider_id":   endpoint.ProviderID,
+	}
+	if endpoint.VpnService.ID != 0 {
+		values["vpn_service_id"] = endpoint.VpnService.ID
+	}
+
+	return setState(d, values)
+}
+
+// Import VPN local endpoint with the ID in the format '<router_id>/<endpoint_id>'
+func (r *routerVpnLocalEndpoint) Import(ctx context.Context, d *utils.Data, meta interface{}) error {
+	return importChild(ctx, d, meta, "router_id", r)
+}
+
+func (r *routerVpnLocalEndpoint) Create(ctx context.Context, d *utils.Data, meta interface{}) error {
+	r.api.setMeta(meta)
+	var tfEndpoint tfRouterVpnLocalEndpoint
+	if err := tftags.Get(d, &tfEndpoint); err != nil {
+		return err
+	}
+
+	resp, err := r.api.CreateRouterVpnLocalEndpoint(ctx, tfEndpoint.RouterID,
+		routerVpnLocalEndpointToRequest(tfEndpoint))
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "creating VPN local endpoint for the router")
+	}
+	tfEndpoint.ID = resp.ID
+
+	return tftags.Set(d, tfEndpoint)
+}
+
+func (r *routerVpnLocalEndpoint) Update(ctx context.Context, d *utils.Data, meta interface{}) error {
+	r.api.setMeta(meta)
+	var tfEndpoint tfRouterVpnLocalEndpoint
+	if err := tftags.Get(d, &tfEndpoint); err != nil {
+		return err
+	}
+
+	resp, err := r.api.UpdateRouterVpnLocalEndpoint(ctx, tfEndpoint.RouterID, tfEndpoint.ID,
+		routerVpnLocalEndpointToRequest(tfEndpoint))
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "updating VPN local endpoint for the router")
+	}
+
+	return nil
+}
+
+func (r *routerVpnLocalEndpoint) Delete(ctx context.Context, d *utils.Data, meta interface{}) error {
+	r.api.setMeta(meta)
+	var tfEndpoint tfRouterVpnLocalEndpoint
+	if err := tftags.Get(d, &tfEndpoint); err != nil {
+		return err
+	}
+
+	resp, err := r.api.DeleteRouterVpnLocalEndpoint(ctx, tfEndpoint.RouterID, tfEndpoint.ID)
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "deleting VPN local endpoint for the router")
+	}
+
+	return nil
+}
+
+func routerVpnLocalEndpointToRequest(tfEndpoint tfRouterVpnLocalEndpoint) routerVpnLocalEndpointRequest {
+	return routerVpnLocalEndpointRequest{
+		LocalEndpoint: routerVpnLocalEndpointBody{
+			Name:         tfEndpoint.Name,
+			Description:  tfEndpoint.Description,
+			VpnService:   models.IDModel{ID: tfEndpoint.VpnServiceID},
+			LocalAddress: tfEndpoint.LocalAddress,
+			LocalID:      tfEndpoint.LocalID,
+		},
+	}
+}
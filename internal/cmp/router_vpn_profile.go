@@ -0,0 +1,406 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package cmp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/tshihad/tftags"
+)
+
+// tfRouterVpnIkeProfile is the terraform model for hpegl_vmaas_router_vpn_ike_profile
+type tfRouterVpnIkeProfile struct {
+	ID                   int      `tf:"id,computed"`
+	RouterID             int      `tf:"router_id"`
+	Name                 string   `tf:"name"`
+	Description          string   `tf:"description"`
+	IkeVersion           string   `tf:"ike_version"`
+	EncryptionAlgorithms []string `tf:"encryption_algorithms"`
+	DigestAlgorithms     []string `tf:"digest_algorithms"`
+	DhGroups             []string `tf:"dh_groups"`
+	SaLifeTime           int      `tf:"sa_life_time"`
+	ProviderID           string   `tf:"provider_id,computed"`
+}
+
+// tfRouterVpnIpsecProfile is the terraform model for hpegl_vmaas_router_vpn_ipsec_profile
+type tfRouterVpnIpsecProfile struct {
+	ID                          int      `tf:"id,computed"`
+	RouterID                    int      `tf:"router_id"`
+	Name                        string   `tf:"name"`
+	Description                 string   `tf:"description"`
+	EncryptionAlgorithms        []string `tf:"encryption_algorithms"`
+	DigestAlgorithms            []string `tf:"digest_algorithms"`
+	DhGroups                    []string `tf:"dh_groups"`
+	EnablePerfectForwardSecrecy bool     `tf:"enable_perfect_forward_secrecy"`
+	SaLifeTime                  int      `tf:"sa_life_time"`
+	DfPolicy                    string   `tf:"df_policy"`
+	ProviderID                  string   `tf:"provider_id,computed"`
+}
+
+// tfRouterVpnDpdProfile is the terraform model for hpegl_vmaas_router_vpn_dpd_profile
+type tfRouterVpnDpdProfile struct {
+	ID               int    `tf:"id,computed"`
+	RouterID         int    `tf:"router_id"`
+	Name             string `tf:"name"`
+	Description      string `tf:"description"`
+	Enabled          bool   `tf:"enabled"`
+	DpdProbeMode     string `tf:"dpd_probe_mode"`
+	DpdProbeInterval int    `tf:"dpd_probe_interval"`
+	RetryCount       int    `tf:"retry_count"`
+	ProviderID       string `tf:"provider_id,computed"`
+}
+
+// routerVpnIkeProfile implements functions related to IKE profiles of IPsec VPN
+type routerVpnIkeProfile struct {
+	rClient *client.RouterAPIService
+	api     *apiService
+}
+
+func newRouterVpnIkeProfile(routerClient *client.RouterAPIService, api *apiService) *routerVpnIkeProfile {
+	return &routerVpnIkeProfile{
+		rClient: routerClient,
+		api:     api,
+	}
+}
+
+func (r *routerVpnIkeProfile) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
+	r.api.setMeta(meta)
+	var tfProfile tfRouterVpnIkeProfile
+	if err := tftags.Get(d, &tfProfile); err != nil {
+		return err
+	}
+
+	resp, err := r.api.GetRouterVpnIkeProfile(ctx, tfProfile.RouterID, tfProfile.ID)
+	if err != nil {
+		return handleNotFound(d, err, "Router VPN IKE profile")
+	}
+	profile := resp.IkeProfile
+
+	return setState(d, map[string]interface{}{
+		"name":                  profile.Name,
+		"description":           profile.Description,
+		"ike_version":           profile.IkeVersion,
+		"encryption_algorithms": profile.EncryptionAlgorithms,
+		"digest_algorithms":     profile.DigestAlgorithms,
+		"dh_groups":             profile.DhGroups,
+		"sa_life_time":          profile.SaLifeTime,
+		"provider_id":           profile.ProviderID,
+	})
+}
+
+// Import IKE profile with the ID in the format '<router_id>/<profile_id>'
+func (r *routerVpnIkeProfile) Import(ctx context.Context, d *utils.Data, meta interface{}) error {
+	return importChild(ctx, d, meta, "router_id", r)
+}
+
+func (r *routerVpnIkeProfile) Create(ctx context.Context, d *utils.Data, meta interface{}) error {
+	setMeta(meta, r.rClient.Client)
+	r.api.setMeta(meta)
+	var tfProfile tfRouterVpnIkeProfile
+	if err := tftags.Get(d, &tfProfile); err != nil {
+		return err
+	}
+
+	if err := validateVpnRouter(ctx, r.rClient, tfProfile.RouterID); err != nil {
+		return err
+	}
+	resp, err := r.api.CreateRouterVpnIkeProfile(ctx, tfProfile.RouterID, routerVpnIkeProfileToRequest(tfProfile))
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "creating VPN IKE profile for the router")
+	}
+	tfProfile.ID = resp.ID
+
+	return tftags.Set(d, tfProfile)
+}
+
+func (r *routerVpnIkeProfile) Update(ctx context.Context, d *utils.Data, meta interface{}) error {
+	r.api.setMeta(meta)
+	var tfProfile tfRouterVpnIkeProfile
+	if err := tftags.Get(d, &tfProfile); err != nil {
+		return err
+	}
+
+	resp, err := r.api.UpdateRouterVpnIkeProfile(ctx, tfProfile.RouterID, tfProfile.ID,
+		routerVpnIkeProfileToRequest(tfProfile))
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "updating VPN IKE profile for the router")
+	}
+
+	return nil
+}
+
+func (r *routerVpnIkeProfile) Delete(ctx context.Context, d *utils.Data, meta interface{}) error {
+	r.api.setMeta(meta)
+	var tfProfile tfRouterVpnIkeProfile
+	if err := tftags.Get(d, &tfProfile); err != nil {
+		return err
+	}
+
+	resp, err := r.api.DeleteRouterVpnIkeProfile(ctx, tfProfile.RouterID, tfProfile.ID)
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "deleting VPN IKE profile for the router")
+	}
+
+	return nil
+}
+
+func routerVpnIkeProfileToRequest(tfProfile tfRouterVpnIkeProfile) routerVpnIkeProfileRequest {
+	return routerVpnIkeProfileRequest{
+		IkeProfile: routerVpnIkeProfileBody{
+			Name:                 tfProfile.Name,
+			Description:          tfProfile.Description,
+			IkeVersion:           tfProfile.IkeVersion,
+			EncryptionAlgorithms: tfProfile.EncryptionAlgorithms,
+			DigestAlgorithms:     tfProfile.DigestAlgorithms,
+			DhGroups:             tfProfile.DhGroups,
+			SaLifeTime:           tfProfile.SaLifeTime,
+		},
+	}
+}
+
+// routerVpnIpsecProfile implements functions related to IPsec tunnel profiles of IPsec VPN
+type routerVpnIpsecProfile struct {
+	rClient *client.RouterAPIService
+	api     *apiService
+}
+
+func newRouterVpnIpsecProfile(routerClient *client.RouterAPIService, api *apiService) *routerVpnIpsecProfile {
+	return &routerVpnIpsecProfile{
+		rClient: routerClient,
+		api:     api,
+	}
+}
+
+func (r *routerVpnIpsecProfile) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
+	r.api.setMeta(meta)
+	var tfProfile tfRouterVpnIpsecProfile
+	if err := tftags.Get(d, &tfProfile); err != nil {
+		return err
+	}
+
+	resp, err := r.api.GetRouterVpnTunnelProfile(ctx, tfProfile.RouterID, tfProfile.ID)
+	if err != nil {
+		return handleNotFound(d, err, "Router VPN IPsec profile")
+	}
+	profile := resp.TunnelProfile
+
+	return setState(d, map[string]interface{}{
+		"name":                           profile.Name,
+		"description":                    profile.Description,
+		"encryption_algorithms":          profile.EncryptionAlgorithms,
+		"digest_algorithms":              profile.DigestAlgorithms,
+		"dh_groups":                      profile.DhGroups,
+		"enable_perfect_forward_secrecy": profile.EnablePerfectForwardSecrecy,
+		"sa_life_time":                   profile.SaLifeTime,
+		"df_policy":                      profile.DfPolicy,
+		"provider_id":                    profile.ProviderID,
+	})
+}
+
+// Import IPsec profile with the ID in the format '<router_id>/<profile_id>'
+func (r *routerVpnIpsecProfile) Import(ctx context.Context, d *utils.Data, meta interface{}) error {
+	return importChild(ctx, d, meta, "router_id", r)
+}
+
+func (r *routerVpnIpsecProfile) Create(ctx context.Context, d *utils.Data, meta interface{}) error {
+	setMeta(meta, r.rClient.Client)
+	r.api.setMeta(meta)
+	var tfProfile tfRouterVpnIpsecProfile
+	if err := tftags.Get(d, &tfProfile); err != nil {
+		return err
+	}
+
+	if err := validateVpnRouter(ctx, r.rClient, tfProfile.RouterID); err != nil {
+		return err
+	}
+	resp, err := r.api.CreateRouterVpnTunnelProfile(ctx, tfProfile.RouterID, routerVpnIpsecProfileToRequest(tfProfile))
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "creating VPN IPsec profile for the router")
+	}
+	tfProfile.ID = resp.ID
+
+	return tftags.Set(d, tfProfile)
+}
+
+func (r *routerVpnIpsecProfile) Update(ctx context.Context, d *utils.Data, meta interface{}) error {
+	r.api.setMeta(meta)
+	var tfProfile tfRouterVpnIpsecProfile
+	if err := tftags.Get(d, &tfProfile); err != nil {
+		return err
+	}
+
+	resp, err := r.api.UpdateRouterVpnTunnelProfile(ctx, tfProfile.RouterID, tfProfile.ID,
+		routerVpnIpsecProfileToRequest(tfProfile))
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "updating VPN IPsec profile for the router")
+	}
+
+	return nil
+}
+
+func (r *routerVpnIpsecProfile) Delete(ctx context.Context, d *utils.Data, meta interface{}) error {
+	r.api.setMeta(meta)
+	var tfProfile tfRouterVpnIpsecProfile
+	if err := tftags.Get(d, &tfProfile); err != nil {
+		return err
+	}
+
+	resp, err := r.api.DeleteRouterVpnTunnelProfile(ctx, tfProfile.RouterID, tfProfile.ID)
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "deleting VPN IPsec profile for the router")
+	}
+
+	return nil
+}
+
+func routerVpnIpsecProfileToRequest(tfProfile tfRouterVpnIpsecProfile) routerVpnTunnelProfileRequest {
+	return routerVpnTunnelProfileRequest{
+		TunnelProfile: routerVpnTunnelProfileBody{
+			Name:                        tfProfile.Name,
+			Description:                 tfProfile.Description,
+			EncryptionAlgorithms:        tfProfile.EncryptionAlgorithms,
+			DigestAlgorithms:            tfProfile.DigestAlgorithms,
+			DhGroups:                    tfProfile.DhGroups,
+			EnablePerfectForwardSecrecy: tfProfile.EnablePerfectForwardSecrecy,
+			SaLifeTime:                  tfProfile.SaLifeTime,
+			DfPolicy:                    tfProfile.DfPolicy,
+		},
+	}
+}
+
+// routerVpnDpdProfile implements functions related to dead peer detection
+// profiles of IPsec VPN
+type routerVpnDpdProfile struct {
+	rClient *client.RouterAPIService
+	api     *apiService
+}
+
+func newRouterVpnDpdProfile(routerClient *client.RouterAPIService, api *apiService) *routerVpnDpdProfile {
+	return &routerVpnDpdProfile{
+		rClient: routerClient,
+		api:     api,
+	}
+}
+
+func (r *routerVpnDpdProfile) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
+	r.api.setMeta(meta)
+	var tfProfile tfRouterVpnDpdProfile
+	if err := tftags.Get(d, &tfProfile); err != nil {
+		return err
+	}
+
+	resp, err := r.api.GetRouterVpnDpdProfile(ctx, tfProfile.RouterID, tfProfile.ID)
+	if err != nil {
+		return handleNotFound(d, err, "Router VPN DPD profile")
+	}
+	profile := resp.DpdProfile
+
+	return setState(d, map[string]interface{}{
+		"name":               profile.Name,
+		"description":        profile.Description,
+		"enabled":            profile.Enabled,
+		"dpd_probe_mode":     profile.DpdProbeMode,
+		"dpd_probe_interval": profile.DpdProbeInterval,
+		"retry_count":        profile.RetryCount,
+		"provider_id":        profile.ProviderID,
+	})
+}
+
+// Import DPD profile with the ID in the format '<router_id>/<profile_id>'
+func (r *routerVpnDpdProfile) Import(ctx context.Context, d *utils.Data, meta interface{}) error {
+	return importChild(ctx, d, meta, "router_id", r)
+}
+
+func (r *routerVpnDpdProfile) Create(ctx context.Context, d *utils.Data, meta interface{}) error {
+	setMeta(meta, r.rClient.Client)
+	r.api.setMeta(meta)
+	var tfProfile tfRouterVpnDpdProfile
+	if err := tftags.Get(d, &tfProfile); err != nil {
+		return err
+	}
+
+	if err := validateVpnRouter(ctx, r.rClient, tfProfile.RouterID); err != nil {
+		return err
+	}
+	resp, err := r.api.CreateRouterVpnDpdProfile(ctx, tfProfile.RouterID, routerVpnDpdProfileToRequest(tfProfile))
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "creating VPN DPD profile for the router")
+	}
+	tfProfile.ID = resp.ID
+
+	return tftags.Set(d, tfProfile)
+}
+
+func (r *routerVpnDpdProfile) Update(ctx context.Context, d *utils.Data, meta interface{}) error {
+	r.api.setMeta(meta)
+	var tfProfile tfRouterVpnDpdProfile
+	if err := tftags.Get(d, &tfProfile); err != nil {
+		return err
+	}
+
+	resp, err := r.api.UpdateRouterVpnDpdProfile(ctx, tfProfile.RouterID, tfProfile.ID,
+		routerVpnDpdProfileToRequest(tfProfile))
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "updating VPN DPD profile for the router")
+	}
+
+	return nil
+}
+
+func (r *routerVpnDpdProfile) Delete(ctx context.Context, d *utils.Data, meta interface{}) error {
+	r.api.setMeta(meta)
+	var tfProfile tfRouterVpnDpdProfile
+	if err := tftags.Get(d, &tfProfile); err != nil {
+		return err
+	}
+
+	resp, err := r.api.DeleteRouterVpnDpdProfile(ctx, tfProfile.RouterID, tfProfile.ID)
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "deleting VPN DPD profile for the router")
+	}
+
+	return nil
+}
+
+func routerVpnDpdProfileToRequest(tfProfile tfRouterVpnDpdProfile) routerVpnDpdProfileRequest {
+	return routerVpnDpdProfileRequest{
+		DpdProfile: routerVpnDpdProfileBody{
+			Name:             tfProfile.Name,
+			Description:      tfProfile.Description,
+			Enabled:          tfProfile.Enabled,
+			DpdProbeMode:     tfProfile.DpdProbeMode,
+			DpdProbeInterval: tfProfile.DpdProbeInterval,
+			RetryCount:       tfProfile.RetryCount,
+		},
+	}
+}
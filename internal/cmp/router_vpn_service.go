@@ -0,0 +1,157 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package cmp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/tshihad/tftags"
+)
+
+// tfRouterVpnService is the terraform model for hpegl_vmaas_router_vpn_service
+type tfRouterVpnService struct {
+	ID          int    `tf:"id,computed"`
+	RouterID    int    `tf:"router_id"`
+	Name        string `tf:"name"`
+	Description string `tf:"description"`
+	Enabled     bool   `tf:"enabled"`
+	IkeLogLevel string `tf:"ike_log_level"`
+	HaSync      bool   `tf:"ha_sync"`
+	ProviderID  string `tf:"provider_id,computed"`
+}
+
+// routerVpnService implements functions related to IPsec VPN service of a router
+type routerVpnService struct {
+	rClient *client.RouterAPIService
+	api     *apiService
+}
+
+func newRouterVpnService(routerClient *client.RouterAPIService, api *apiService) *routerVpnService {
+	return &routerVpnService{
+		rClient: routerClient,
+		api:     api,
+	}
+}
+
+func (r *routerVpnService) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
+	r.api.setMeta(meta)
+	var tfService tfRouterVpnService
+	if err := tftags.Get(d, &tfService); err != nil {
+		return err
+	}
+
+	resp, err := r.api.GetRouterVpnService(ctx, tfService.RouterID, tfService.ID)
+	if err != nil {
+		return handleNotFound(d, err, "Router VPN service")
+	}
+	service := resp.VpnService
+
+	return setState(d, map[string]interface{}{
+		"name":          service.Name,
+		"description":   service.Description,
+		"enabled":       service.Enabled,
+		"ike_log_level": service.Config.IkeLogLevel,
+		"ha_sync":       service.Config.HaSync,
+		"provider_id":   service.ProviderID,
+	})
+}
+
+// Import VPN service with the ID in the format '<router_id>/<service_id>'
+func (r *routerVpnService) Import(ctx context.Context, d *utils.Data, meta interface{}) error {
+	return importChild(ctx, d, meta, "router_id", r)
+}
+
+func (r *routerVpnService) Create(ctx context.Context, d *utils.Data, meta interface{}) error {
+	setMeta(meta, r.rClient.Client)
+	r.api.setMeta(meta)
+	var tfService tfRouterVpnService
+	if err := tftags.Get(d, &tfService); err != nil {
+		return err
+	}
+
+	if err := validateVpnRouter(ctx, r.rClient, tfService.RouterID); err != nil {
+		return err
+	}
+	resp, err := r.api.CreateRouterVpnService(ctx, tfService.RouterID, routerVpnServiceToRequest(tfService))
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "creating VPN service for the router")
+	}
+	tfService.ID = resp.ID
+
+	return tftags.Set(d, tfService)
+}
+
+func (r *routerVpnService) Update(ctx context.Context, d *utils.Data, meta interface{}) error {
+	r.api.setMeta(meta)
+	var tfService tfRouterVpnService
+	if err := tftags.Get(d, &tfService); err != nil {
+		return err
+	}
+
+	resp, err := r.api.UpdateRouterVpnService(ctx, tfService.RouterID, tfService.ID,
+		routerVpnServiceToRequest(tfService))
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "updating VPN service for the router")
+	}
+
+	return nil
+}
+
+func (r *routerVpnService) Delete(ctx context.Context, d *utils.Data, meta interface{}) error {
+	r.api.setMeta(meta)
+	var tfService tfRouterVpnService
+	if err := tftags.Get(d, &tfService); err != nil {
+		return err
+	}
+
+	resp, err := r.api.DeleteRouterVpnService(ctx, tfService.RouterID, tfService.ID)
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "deleting VPN service for the router")
+	}
+
+	return nil
+}
+
+func routerVpnServiceToRequest(tfService tfRouterVpnService) routerVpnServiceRequest {
+	return routerVpnServiceRequest{
+		VpnService: routerVpnServiceBody{
+			Name:        tfService.Name,
+			Description: tfService.Description,
+			Enabled:     tfService.Enabled,
+			Config: routerVpnServiceConfig{
+				IkeLogLevel: tfService.IkeLogLevel,
+				HaSync:      tfService.HaSync,
+			},
+		},
+	}
+}
+
+// validateVpnRouter checks whether the router belongs to the NSX network server,
+// since IPsec VPN is supported only on NSX Tier-0 and Tier-1 Gateways
+func validateVpnRouter(ctx context.Context, rClient *client.RouterAPIService, routerID int) error {
+	serverID, err := getNsxNetworkServerID(ctx, rClient)
+	if err != nil {
+		return err
+	}
+	routerResp, err := rClient.GetSpecificRouter(ctx, routerID)
+	if err != nil {
+		return err
+	}
+	if routerResp.NetworkRouter.NetworkServer.ID != serverID {
+		return fmt.Errorf("IPsec VPN is supported only on NSX gateways, router %d is not a NSX gateway", routerID)
+	}
+
+	return nil
+}
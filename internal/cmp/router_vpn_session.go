@@ -0,0 +1,194 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package cmp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/tshihad/tftags"
+)
+
+// tfRouterVpnSession is the terraform model for hpegl_vmaas_router_vpn_session
+type tfRouterVpnSession struct {
+	ID                       int                    `tf:"id,computed"`
+	RouterID                 int                    `tf:"router_id"`
+	VpnServiceID             int                    `tf:"vpn_service_id"`
+	LocalEndpointID          int                    `tf:"local_endpoint_id"`
+	Name                     string                 `tf:"name"`
+	Description              string                 `tf:"description"`
+	Enabled                  bool                   `tf:"enabled"`
+	Type                     string                 `tf:"type"`
+	PeerAddress              string                 `tf:"peer_address"`
+	PeerID                   string                 `tf:"peer_id"`
+	Psk                      string                 `tf:"psk"`
+	IkeProfileID             int                    `tf:"ike_profile_id"`
+	IpsecProfileID           int                    `tf:"ipsec_profile_id"`
+	DpdProfileID             int                    `tf:"dpd_profile_id"`
+	ConnectionInitiationMode string                 `tf:"connection_initiation_mode"`
+	TunnelInterfaceCidr      string                 `tf:"tunnel_interface_cidr"`
+	Rules                    []routerVpnSessionRule `tf:"rule"`
+	ProviderID               string                 `tf:"provider_id,computed"`
+}
+
+// routerVpnSession implements functions related to policy based and route based
+// IPsec VPN sessions of a router
+type routerVpnSession struct {
+	api *apiService
+}
+
+func newRouterVpnSession(api *apiService) *routerVpnSession {
+	return &routerVpnSession{
+		api: api,
+	}
+}
+
+func (r *routerVpnSession) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
+	r.api.setMeta(meta)
+	var tfSession tfRouterVpnSession
+	if err := tftags.Get(d, &tfSession); err != nil {
+		return err
+	}
+
+	resp, err := r.api.GetRouterVpnSession(ctx, tfSession.RouterID, tfSession.ID)
+	if err != nil {
+		return handleNotFound(d, err, "Router VPN session")
+	}
+
+	// pre-shared key is not returned by the API, hence psk is kept as it is in the state
+	session := resp.Session
+	values := map[string]interface{}{
+		"name":                       session.Name,
+		"description":                session.Description,
+		"enabled":                    session.Enabled,
+		"type":                       session.SessionType,
+		"peer_address":               session.PeerAddress,
+		"peer_id":                    session.PeerID,
+		"connection_initiation_mode": session.ConnectionInitiationMode,
+		"tunnel_interface_cidr":      session.TunnelInterfaceCidr,
+		"rule":                       routerVpnSessionRulesToList(session.Rules),
+		"provider_id":                session.ProviderID,
+	}
+	if session.VpnService.ID != 0 {
+		values["vpn_service_id"] = session.VpnService.ID
+	}
+	if session.LocalEndpoint.ID != 0 {
+		values["local_endpoint_id"] = session.LocalEndpoint.ID
+	}
+	if session.IkeProfile != nil {
+		values["ike_profile_id"] = session.IkeProfile.ID
+	}
+	if session.TunnelProfile != nil {
+		values["ipsec_profile_id"] = session.TunnelProfile.ID
+	}
+	if session.DpdProfile != nil {
+		values["dpd_profile_id"] = session.DpdProfile.ID
+	}
+
+	return setState(d, values)
+}
+
+// Import VPN session with the ID in the format '<router_id>/<session_id>'
+func (r *routerVpnSession) Import(ctx context.Context, d *utils.Data, meta interface{}) error {
+	return importChild(ctx, d, meta, "router_id", r)
+}
+
+func (r *routerVpnSession) Create(ctx context.Context, d *utils.Data, meta interface{}) error {
+	r.api.setMeta(meta)
+	var tfSession tfRouterVpnSession
+	if err := tftags.Get(d, &tfSession); err != nil {
+		return err
+	}
+
+	resp, err := r.api.CreateRouterVpnSession(ctx, tfSession.RouterID, routerVpnSessionToRequest(tfSession))
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "creating VPN session for the router")
+	}
+	tfSession.ID = resp.ID
+
+	return tftags.Set(d, tfSession)
+}
+
+func (r *routerVpnSession) Update(ctx context.Context, d *utils.Data, meta interface{}) error {
+	r.api.setMeta(meta)
+	var tfSession tfRouterVpnSession
+	if err := tftags.Get(d, &tfSession); err != nil {
+		return err
+	}
+
+	resp, err := r.api.UpdateRouterVpnSession(ctx, tfSession.RouterID, tfSession.ID,
+		routerVpnSessionToRequest(tfSession))
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "updating VPN session for the router")
+	}
+
+	return nil
+}
+
+func (r *routerVpnSession) Delete(ctx context.Context, d *utils.Data, meta interface{}) error {
+	r.api.setMeta(meta)
+	var tfSession tfRouterVpnSession
+	if err := tftags.Get(d, &tfSession); err != nil {
+		return err
+	}
+
+	resp, err := r.api.DeleteRouterVpnSession(ctx, tfSession.RouterID, tfSession.ID)
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "deleting VPN session for the router")
+	}
+
+	return nil
+}
+
+func routerVpnSessionToRequest(tfSession tfRouterVpnSession) routerVpnSessionRequest {
+	body := routerVpnSessionBody{
+		Name:                     tfSession.Name,
+		Description:              tfSession.Description,
+		Enabled:                  tfSession.Enabled,
+		SessionType:              tfSession.Type,
+		VpnService:               models.IDModel{ID: tfSession.VpnServiceID},
+		LocalEndpoint:            models.IDModel{ID: tfSession.LocalEndpointID},
+		PeerAddress:              tfSession.PeerAddress,
+		PeerID:                   tfSession.PeerID,
+		Psk:                      tfSession.Psk,
+		ConnectionInitiationMode: tfSession.ConnectionInitiationMode,
+		TunnelInterfaceCidr:      tfSession.TunnelInterfaceCidr,
+		Rules:                    tfSession.Rules,
+	}
+	// default profiles of NSX are used if the profiles are not set
+	if tfSession.IkeProfileID != 0 {
+		body.IkeProfile = &models.IDModel{ID: tfSession.IkeProfileID}
+	}
+	if tfSession.IpsecProfileID != 0 {
+		body.TunnelProfile = &models.IDModel{ID: tfSession.IpsecProfileID}
+	}
+	if tfSession.DpdProfileID != 0 {
+		body.DpdProfile = &models.IDModel{ID: tfSession.DpdProfileID}
+	}
+
+	return routerVpnSessionRequest{Session: body}
+}
+
+func routerVpnSessionRulesToList(rules []routerVpnSessionRule) []map[string]interface{} {
+	list := make([]map[string]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		list = append(list, map[string]interface{}{
+			"sources":      rule.Sources,
+			"destinations": rule.Destinations,
+			"action":       rule.Action,
+		})
+	}
+
+	return list
+}
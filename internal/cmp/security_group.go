@@ -0,0 +1,181 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package cmp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/tshihad/tftags"
+)
+
+// tfSecurityGroup is the terraform model for hpegl_vmaas_security_group
+type tfSecurityGroup struct {
+	ID              int                `tf:"id,computed"`
+	NetworkServerID int                `tf:"network_server_id,computed"`
+	Name            string             `tf:"name"`
+	Description     string             `tf:"description"`
+	Tags            []securityGroupTag `tf:"tag"`
+	InstanceIDs     []int              `tf:"instance_ids"`
+	SegmentIDs      []int              `tf:"segment_ids"`
+	IPAddresses     []string           `tf:"ip_addresses"`
+	ExternalID      string             `tf:"external_id,computed"`
+}
+
+// securityGroup implements functions related to NSX groups, which are used
+// as sources and destinations of distributed firewall rules
+type securityGroup struct {
+	rClient *client.RouterAPIService
+	api     *apiService
+}
+
+func newSecurityGroup(routerClient *client.RouterAPIService, api *apiService) *securityGroup {
+	return &securityGroup{
+		rClient: routerClient,
+		api:     api,
+	}
+}
+
+func (s *securityGroup) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
+	setMeta(meta, s.rClient.Client)
+	s.api.setMeta(meta)
+	var tfGroup tfSecurityGroup
+	if err := tftags.Get(d, &tfGroup); err != nil {
+		return err
+	}
+
+	if tfGroup.NetworkServerID == 0 {
+		serverID, err := getNsxNetworkServerID(ctx, s.rClient)
+		if err != nil {
+			return err
+		}
+		tfGroup.NetworkServerID = serverID
+	}
+	resp, err := s.api.GetSecurityGroup(ctx, tfGroup.NetworkServerID, tfGroup.ID)
+	if err != nil {
+		return handleNotFound(d, err, "Security group")
+	}
+	group := resp.Group
+
+	tags := make([]map[string]interface{}, 0, len(group.Config.Tags))
+	for _, t := range group.Config.Tags {
+		tags = append(tags, map[string]interface{}{
+			"scope": t.Scope,
+			"tag":   t.Tag,
+		})
+	}
+
+	return setState(d, map[string]interface{}{
+		"network_server_id": tfGroup.NetworkServerID,
+		"name":              group.Name,
+		"description":       group.Description,
+		"tag":               tags,
+		"instance_ids":      idModelsToList(group.Config.Instances),
+		"segment_ids":       idModelsToList(group.Config.Networks),
+		"ip_addresses":      group.Config.IPAddresses,
+		"external_id":       group.ExternalID,
+	})
+}
+
+// Import security group with the ID in the format '<network_server_id>/<group_id>'
+func (s *securityGroup) Import(ctx context.Context, d *utils.Data, meta interface{}) error {
+	return importChild(ctx, d, meta, "network_server_id", s)
+}
+
+func (s *securityGroup) Create(ctx context.Context, d *utils.Data, meta interface{}) error {
+	setMeta(meta, s.rClient.Client)
+	s.api.setMeta(meta)
+	var tfGroup tfSecurityGroup
+	if err := tftags.Get(d, &tfGroup); err != nil {
+		return err
+	}
+
+	serverID, err := getNsxNetworkServerID(ctx, s.rClient)
+	if err != nil {
+		return err
+	}
+	resp, err := s.api.CreateSecurityGroup(ctx, serverID, securityGroupToRequest(tfGroup))
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "creating security group")
+	}
+	tfGroup.ID = resp.ID
+	tfGroup.NetworkServerID = serverID
+
+	return tftags.Set(d, tfGroup)
+}
+
+func (s *securityGroup) Update(ctx context.Context, d *utils.Data, meta interface{}) error {
+	s.api.setMeta(meta)
+	var tfGroup tfSecurityGroup
+	if err := tftags.Get(d, &tfGroup); err != nil {
+		return err
+	}
+
+	resp, err := s.api.UpdateSecurityGroup(ctx, tfGroup.NetworkServerID, tfGroup.ID,
+		securityGroupToRequest(tfGroup))
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "updating security group")
+	}
+
+	return nil
+}
+
+func (s *securityGroup) Delete(ctx context.Context, d *utils.Data, meta interface{}) error {
+	s.api.setMeta(meta)
+	var tfGroup tfSecurityGroup
+	if err := tftags.Get(d, &tfGroup); err != nil {
+		return err
+	}
+
+	resp, err := s.api.DeleteSecurityGroup(ctx, tfGroup.NetworkServerID, tfGroup.ID)
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "deleting security group")
+	}
+
+	return nil
+}
+
+func securityGroupToRequest(tfGroup tfSecurityGroup) securityGroupRequest {
+	return securityGroupRequest{
+		Group: securityGroupBody{
+			Name:        tfGroup.Name,
+			Description: tfGroup.Description,
+			Config: securityGroupConfig{
+				Tags:        tfGroup.Tags,
+				Instances:   listToIDModels(tfGroup.InstanceIDs),
+				Networks:    listToIDModels(tfGroup.SegmentIDs),
+				IPAddresses: tfGroup.IPAddresses,
+			},
+		},
+	}
+}
+
+func listToIDModels(ids []int) []models.IDModel {
+	idModels := make([]models.IDModel, 0, len(ids))
+	for _, id := range ids {
+		idModels = append(idModels, models.IDModel{ID: id})
+	}
+
+	return idModels
+}
+
+func idModelsToList(idModels []models.IDModel) []int {
+	ids := make([]int, 0, len(idModels))
+	for _, m := range idModels {
+		ids = append(ids, m.ID)
+	}
+
+	return ids
+}
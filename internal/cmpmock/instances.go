@@ -0,0 +1,356 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package cmpmock
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const (
+	instancesPath = "instances"
+	serversPath   = "servers"
+	snapshotsPath = "snapshots"
+	historyPath   = "history"
+	vmware        = "vmware"
+)
+
+// instance power actions and the status of the instance after the action
+var instanceActions = map[string]string{
+	"start":   "running",
+	"restart": "running",
+	"stop":    "stopped",
+	"suspend": "suspended",
+}
+
+// serveInstances serves instance APIs which are not plain REST collections. Creating
+// an instance also creates its server, and each action on the instance is recorded
+// on the instance history with completed status. Returns false if the request
+// should be served as collection.
+func (s *Server) serveInstances(w http.ResponseWriter, method string, segments []string, body map[string]interface{}) bool {
+	if segments[0] == snapshotsPath && len(segments) == 2 && method == http.MethodDelete {
+		s.deleteSnapshot(w, segments[1])
+
+		return true
+	}
+	if segments[0] != instancesPath {
+		return false
+	}
+
+	switch {
+	case len(segments) == 1 && method == http.MethodPost:
+		s.createInstance(w, body)
+	case len(segments) == 2 && method == http.MethodPut:
+		s.updateInstance(w, segments[1], body)
+	case len(segments) == 2 && method == http.MethodDelete:
+		s.deleteInstance(w, segments[1])
+	case len(segments) == 3:
+		s.serveInstanceAction(w, method, segments[1], segments[2], body)
+	case len(segments) == 4 && segments[2] == "revert-snapshot" && method == http.MethodPut:
+		s.revertSnapshot(w, segments[1], segments[3])
+	default:
+		return false
+	}
+
+	return true
+}
+
+func (s *Server) serveInstanceAction(
+	w http.ResponseWriter,
+	method, id, action string,
+	body map[string]interface{},
+) {
+	instance := s.find(instancesPath, id)
+	if instance == nil {
+		writeNotFound(w, "instance", id)
+
+		return
+	}
+
+	switch status, isPowerAction := instanceActions[action]; {
+	case method == http.MethodGet && action == historyPath:
+		writeJSON(w, http.StatusOK, map[string]interface{}{"processes": s.items(instanceSubPath(id, historyPath))})
+	case method == http.MethodGet && action == snapshotsPath:
+		writeJSON(w, http.StatusOK, map[string]interface{}{"snapshots": s.items(instanceSubPath(id, snapshotsPath))})
+	case method == http.MethodPut && isPowerAction:
+		instance["status"] = status
+		s.addProcess(id, action)
+		writeJSON(w, http.StatusOK, map[string]interface{}{"success": true})
+	case method == http.MethodPut && action == "resize":
+		s.resizeInstance(w, instance, body)
+	case method == http.MethodPut && action == "snapshot":
+		snapshot := mapOf(body["snapshot"])
+		s.add(instanceSubPath(id, snapshotsPath), map[string]interface{}{
+			"name":         snapshot["name"],
+			"description":  snapshot["description"],
+			"status":       "complete",
+			"snapshotType": "vm",
+		})
+		s.addProcess(id, "snapshot")
+		writeJSON(w, http.StatusOK, map[string]interface{}{"success": true})
+	case method == http.MethodPut && action == "import-snapshot":
+		s.addProcess(id, "import")
+		writeJSON(w, http.StatusOK, map[string]interface{}{"success": true})
+	case method == http.MethodPut && action == "clone":
+		s.cloneInstance(w, instance, body)
+	default:
+		writeError(w, http.StatusNotFound,
+			fmt.Sprintf("%s instances/%s/%s is not supported by the mock", method, id, action))
+	}
+}
+
+func (s *Server) createInstance(w http.ResponseWriter, body map[string]interface{}) {
+	req := mapOf(body["instance"])
+	config := mapOf(body["config"])
+	if strings.EqualFold(fmt.Sprint(mapOf(req["instanceType"])["code"]), vmware) && config["template"] == nil {
+		writeError(w, http.StatusBadRequest, "template is required for vmware instance")
+
+		return
+	}
+	if body["powerScheduleType"] != nil {
+		config["powerScheduleType"] = body["powerScheduleType"]
+	}
+
+	instance := map[string]interface{}{
+		"name":              req["name"],
+		"hostName":          req["hostName"],
+		"environmentPrefix": req["environmentPrefix"],
+		"instanceType":      req["instanceType"],
+		"group":             req["site"],
+		"cloud":             map[string]interface{}{"id": body["zoneId"]},
+		"layout":            req["layout"],
+		"plan":              req["plan"],
+		"config":            config,
+		"labels":            body["labels"],
+		"tags":              body["tags"],
+		"evars":             body["evars"],
+		"status":            "running",
+		"containerDetails":  []interface{}{},
+	}
+	instance["volumes"] = s.instanceVolumes(body["volumes"])
+	s.add(instancesPath, instance)
+	s.setInstanceInterfaces(instance, body["networkInterfaces"])
+	s.addProcess(fmt.Sprint(instance["id"]), "provision")
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"instance": instance})
+}
+
+func (s *Server) cloneInstance(w http.ResponseWriter, source, body map[string]interface{}) {
+	// copy the source, so that the source is not updated along with the clone
+	clone, err := deepCopy(source)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+
+		return
+	}
+	delete(clone, "interfaces")
+	clone["name"] = body["name"]
+	clone["status"] = "running"
+	for _, key := range []string{"cloud", "group", "layout", "plan", "instanceType"} {
+		if v := mapOf(body[key]); len(v) > 0 && fmt.Sprint(v["id"]) != "0" {
+			clone[key] = v
+		}
+	}
+	if volumes, ok := body["volumes"].([]interface{}); ok && len(volumes) > 0 {
+		clone["volumes"] = s.instanceVolumes(volumes)
+	}
+	s.add(instancesPath, clone)
+
+	interfaces := body["networkInterfaces"]
+	if _, ok := interfaces.([]interface{}); !ok {
+		interfaces = source["interfaces"]
+	}
+	s.setInstanceInterfaces(clone, interfaces)
+	s.addProcess(fmt.Sprint(source["id"]), "cloning")
+	s.addProcess(fmt.Sprint(clone["id"]), "cloning")
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"success": true})
+}
+
+func (s *Server) resizeInstance(w http.ResponseWriter, instance, body map[string]interface{}) {
+	if plan := mapOf(mapOf(body["instance"])["plan"]); len(plan) > 0 {
+		instance["plan"] = plan
+	}
+	if volumes, ok := body["volumes"].([]interface{}); ok && len(volumes) > 0 {
+		instance["volumes"] = s.instanceVolumes(volumes)
+	}
+	if interfaces, ok := body["networkInterfaces"].([]interface{}); ok && len(interfaces) > 0 {
+		s.setInstanceInterfaces(instance, interfaces)
+	}
+	s.addProcess(fmt.Sprint(instance["id"]), "resize")
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"success":  true,
+		"instance": instance,
+	})
+}
+
+// updateInstance updates the instance, server is renamed along with the instance
+func (s *Server) updateInstance(w http.ResponseWriter, id string, body map[string]interface{}) {
+	instance := s.find(instancesPath, id)
+	if instance == nil {
+		writeNotFound(w, "instance", id)
+
+		return
+	}
+	if server := s.instanceServer(instance); server != nil {
+		defer func() {
+			server["name"] = instance["name"]
+			server["externalName"] = instance["name"]
+		}()
+	}
+	merge(instance, unwrap(body, "instance"))
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"success":  true,
+		"instance": instance,
+	})
+}
+
+// deleteInstance deletes the instance along with its server, snapshots and history
+func (s *Server) deleteInstance(w http.ResponseWriter, id string) {
+	instance := s.find(instancesPath, id)
+	if instance == nil {
+		writeNotFound(w, "instance", id)
+
+		return
+	}
+	s.remove(instancesPath, id)
+	if server := s.instanceServer(instance); server != nil {
+		s.remove(serversPath, fmt.Sprint(server["id"]))
+	}
+	delete(s.store, instanceSubPath(id, snapshotsPath))
+	delete(s.store, instanceSubPath(id, historyPath))
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"success": true})
+}
+
+func (s *Server) revertSnapshot(w http.ResponseWriter, id, snapshotID string) {
+	if s.find(instancesPath, id) == nil {
+		writeNotFound(w, "instance", id)
+
+		return
+	}
+	if s.find(instanceSubPath(id, snapshotsPath), snapshotID) == nil {
+		writeNotFound(w, "snapshot", snapshotID)
+
+		return
+	}
+	s.addProcess(id, "revertSnapshot")
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"success": true})
+}
+
+func (s *Server) deleteSnapshot(w http.ResponseWriter, snapshotID string) {
+	for key := range s.store {
+		if strings.HasPrefix(key, instancesPath+"/") && strings.HasSuffix(key, "/"+snapshotsPath) &&
+			s.find(key, snapshotID) != nil {
+			s.remove(key, snapshotID)
+			writeJSON(w, http.StatusOK, map[string]interface{}{"success": true})
+
+			return
+		}
+	}
+	writeNotFound(w, "snapshot", snapshotID)
+}
+
+// setInstanceInterfaces sets interfaces on the instance and its server. Server
+// is created if the instance doesn't have one
+func (s *Server) setInstanceInterfaces(instance map[string]interface{}, networkInterfaces interface{}) {
+	reqInterfaces, _ := networkInterfaces.([]interface{})
+	interfaces := make([]interface{}, 0, len(reqInterfaces))
+	serverInterfaces := make([]interface{}, 0, len(reqInterfaces))
+	for i, n := range reqInterfaces {
+		reqInterface := mapOf(n)
+		interfaceID := reqInterface["id"]
+		if interfaceID == nil || fmt.Sprint(interfaceID) == "0" {
+			interfaceID = s.newID()
+		}
+		interfaces = append(interfaces, map[string]interface{}{
+			"id":                     fmt.Sprint(interfaceID),
+			"network":                reqInterface["network"],
+			"networkInterfaceTypeId": reqInterface["networkInterfaceTypeId"],
+		})
+		serverInterfaces = append(serverInterfaces, map[string]interface{}{
+			"id":               interfaceID,
+			"name":             fmt.Sprintf("eth%d", i),
+			"primaryInterface": i == 0,
+			"ipMode":           reqInterface["ipMode"],
+			"ipAddress":        reqInterface["ipAddress"],
+		})
+	}
+	instance["interfaces"] = interfaces
+
+	if server := s.instanceServer(instance); server != nil {
+		server["interfaces"] = serverInterfaces
+
+		return
+	}
+	server := s.add(serversPath, map[string]interface{}{
+		"name":         instance["name"],
+		"externalName": instance["name"],
+		"powerState":   "on",
+		"interfaces":   serverInterfaces,
+	})
+	instance["servers"] = []interface{}{server["id"]}
+}
+
+// instanceServer returns the server of the instance, server is identified by
+// name same as the provider
+func (s *Server) instanceServer(instance map[string]interface{}) map[string]interface{} {
+	for _, server := range s.store[serversPath] {
+		if server["externalName"] == instance["name"] {
+			return server
+		}
+	}
+
+	return nil
+}
+
+// instanceVolumes assigns ID for new volumes
+func (s *Server) instanceVolumes(reqVolumes interface{}) []interface{} {
+	volumes, _ := reqVolumes.([]interface{})
+	for _, v := range volumes {
+		volume := mapOf(v)
+		if volume["id"] == nil || fmt.Sprint(volume["id"]) == "0" || fmt.Sprint(volume["id"]) == "-1" {
+			volume["id"] = s.newID()
+		}
+	}
+
+	return volumes
+}
+
+func (s *Server) addProcess(instanceID, processType string) {
+	s.add(instanceSubPath(instanceID, historyPath), map[string]interface{}{
+		"processType": map[string]interface{}{
+			"code": processType,
+			"name": processType,
+		},
+		"displayName": processType,
+		"instanceId":  json.Number(instanceID),
+		"status":      "complete",
+		"percent":     100,
+	})
+}
+
+// add stores item on the path with new ID, path need not be a collection
+func (s *Server) add(path string, item map[string]interface{}) map[string]interface{} {
+	item["id"] = s.newID()
+	s.store[path] = append(s.store[path], item)
+
+	return item
+}
+
+func (s *Server) items(path string) []map[string]interface{} {
+	if items := s.store[path]; items != nil {
+		return items
+	}
+
+	return []map[string]interface{}{}
+}
+
+func instanceSubPath(id, path string) string {
+	return fmt.Sprintf("%s/%s/%s", instancesPath, id, path)
+}
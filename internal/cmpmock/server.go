@@ -0,0 +1,480 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+// Package cmpmock provides an in-process stand-in for the CMP REST API, so that
+// the provider can be tested without a VMaaS endpoint or IAM. Objects are kept in
+// memory and seeded from fixtures.json, which follows acc-dev-testcases.
+package cmpmock
+
+import (
+	"bytes"
+	_ "embed" // required for fixtures
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	consts "github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/common"
+)
+
+const (
+	// IDs of the objects created on the mock server starts from here, so that
+	// it won't conflict with the fixtures
+	firstID       = 1000
+	idSegment     = "{id}"
+	collectionKey = "collections"
+	portGroup     = "dvportgroup-"
+)
+
+//go:embed fixtures.json
+var fixturesJSON []byte
+
+// collection defines the REST collection with the keys used by CMP to envelope
+// the list and the item. onCreate is invoked after the ID is assigned for the created item
+type collection struct {
+	pattern  string
+	list     string
+	item     string
+	onCreate func(s *Server, item map[string]interface{})
+}
+
+var collections = []collection{
+	{pattern: "zones", list: "zones", item: "zone"},
+	{pattern: "zones/{id}/folders", list: "folders", item: "folder"},
+	{pattern: "zones/{id}/data-stores", list: "datastores", item: "datastore"},
+	{pattern: "zones/{id}/resource-pools", list: "resourcePools", item: "resourcePool"},
+	{pattern: "groups", list: "groups", item: "group"},
+	{pattern: "environments", list: "environments", item: "environment"},
+	{pattern: "library/instance-types", list: "instanceTypes", item: "instanceType"},
+	{pattern: "library/layouts", list: "instanceTypeLayouts", item: "instanceTypeLayout"},
+	{pattern: "service-plans", list: "servicePlans", item: "servicePlan"},
+	{pattern: "power-schedules", list: "schedules", item: "schedule"},
+	{pattern: "virtual-images", list: "virtualImages", item: "virtualImage"},
+	{pattern: "provision-types", list: "provisionTypes", item: "provisionType"},
+	{pattern: "instances", list: "instances", item: "instance"},
+	{pattern: "servers", list: "servers", item: "server"},
+	{pattern: "networks", list: "networks", item: "network", onCreate: onNetworkCreate},
+	{pattern: "network-types", list: "networkTypes", item: "networkType"},
+	{pattern: "networks/pools", list: "networkPools", item: "networkPool"},
+	{pattern: "networks/pools/{id}/ips", list: "networkPoolIps", item: "networkPoolIp"},
+	{pattern: "networks/proxies", list: "networkProxies", item: "networkProxy"},
+	{pattern: "networks/domains", list: "networkDomains", item: "networkDomain"},
+	{pattern: "networks/services", list: "networkServices", item: "networkService"},
+	{pattern: "networks/servers/{id}/scopes", list: "networkScopes", item: "networkScope"},
+	{pattern: "networks/servers/{id}/edge-clusters", list: "networkEdgeClusters", item: "networkEdgeCluster"},
+	{pattern: "networks/servers/{id}/groups", list: "groups", item: "group"},
+	{pattern: "networks/servers/{id}/dhcp-servers", list: "networkDhcpServers", item: "networkDhcpServer"},
+	{pattern: "networks/servers/{id}/dhcp-relays", list: "networkDhcpRelays", item: "networkDhcpRelay"},
+	{pattern: "networks/{id}/dhcp-static-bindings", list: "networkDhcpStaticBindings", item: "networkDhcpStaticBinding"},
+	{pattern: "networks/servers/{id}/firewall-rule-groups", list: "ruleGroups", item: "ruleGroup"},
+	{pattern: "network-router-types", list: "networkRouterTypes", item: "networkRouterType"},
+	{pattern: "networks/routers", list: "networkRouters", item: "networkRouter", onCreate: onRouterCreate},
+	{pattern: "networks/routers/{id}/nats", list: "networkRouterNATs", item: "networkRouterNAT"},
+	{pattern: "networks/routers/{id}/firewall-rule-groups", list: "ruleGroups", item: "ruleGroup"},
+	{pattern: "networks/routers/{id}/firewall-rules", list: "rules", item: "rule"},
+	{pattern: "networks/routers/{id}/routes", list: "networkRoutes", item: "networkRoute"},
+	{pattern: "networks/routers/{id}/bgp-neighbors", list: "networkRouterBgpNeighbors", item: "networkRouterBgpNeighbor"},
+	{pattern: "networks/routers/{id}/interfaces", list: "networkRouterInterfaces", item: "networkRouterInterface"},
+	{pattern: "networks/routers/{id}/ipsec-vpn-services", list: "networkRouterVpnServices", item: "networkRouterVpnService"},
+	{
+		pattern: "networks/routers/{id}/ipsec-vpn-local-endpoints",
+		list:    "networkRouterVpnLocalEndpoints",
+		item:    "networkRouterVpnLocalEndpoint",
+	},
+	{
+		pattern: "networks/routers/{id}/ipsec-vpn-ike-profiles",
+		list:    "networkRouterVpnIkeProfiles",
+		item:    "networkRouterVpnIkeProfile",
+	},
+	{
+		pattern: "networks/routers/{id}/ipsec-vpn-tunnel-profiles",
+		list:    "networkRouterVpnTunnelProfiles",
+		item:    "networkRouterVpnTunnelProfile",
+	},
+	{
+		pattern: "networks/routers/{id}/ipsec-vpn-dpd-profiles",
+		list:    "networkRouterVpnDpdProfiles",
+		item:    "networkRouterVpnDpdProfile",
+	},
+	{pattern: "networks/routers/{id}/ipsec-vpn-sessions", list: "networkRouterVpnSessions", item: "networkRouterVpnSession"},
+	{pattern: "load-balancer-types", list: "loadBalancerTypes", item: "loadBalancerType"},
+	{pattern: "load-balancers", list: "loadBalancers", item: "loadBalancer"},
+	{pattern: "load-balancers/{id}/monitors", list: "loadBalancerMonitors", item: "loadBalancerMonitor"},
+	{pattern: "load-balancers/{id}/profiles", list: "loadBalancerProfiles", item: "loadBalancerProfile"},
+	{pattern: "load-balancers/{id}/pools", list: "loadBalancerPools", item: "loadBalancerPool"},
+	{pattern: "load-balancers/{id}/virtual-servers", list: "loadBalancerInstances", item: "loadBalancerInstance"},
+	{pattern: "certificates", list: "certificates", item: "certificate"},
+}
+
+// Server is the mock CMP API server. Server is safe to use concurrently, all
+// the requests are served one at a time.
+type Server struct {
+	server    *httptest.Server
+	mu        sync.Mutex
+	nextID    int
+	documents map[string]interface{}
+	// store keeps the items of each collection with the collection path as key,
+	// for example load-balancers/19/pools
+	store map[string][]map[string]interface{}
+}
+
+// NewServer starts the mock server seeded with fixtures. Server should be closed
+// once the tests are completed.
+func NewServer() *Server {
+	s := &Server{
+		nextID:    firstID,
+		documents: make(map[string]interface{}),
+		store:     make(map[string][]map[string]interface{}),
+	}
+	if err := s.loadFixtures(); err != nil {
+		panic(fmt.Sprintf("failed to load cmp mock fixtures, error: %v", err))
+	}
+	s.server = httptest.NewServer(s)
+
+	return s
+}
+
+// URL returns the base URL of the server, which can be used as HPEGL_VMAAS_API_URL
+func (s *Server) URL() string {
+	return s.server.URL
+}
+
+// Close shuts down the server
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+func (s *Server) loadFixtures() error {
+	var fixtures map[string]json.RawMessage
+	if err := decode(fixturesJSON, &fixtures); err != nil {
+		return err
+	}
+	for key, raw := range fixtures {
+		if key == collectionKey {
+			if err := decode(raw, &s.store); err != nil {
+				return err
+			}
+
+			continue
+		}
+		var doc interface{}
+		if err := decode(raw, &doc); err != nil {
+			return err
+		}
+		s.documents[key] = doc
+	}
+
+	return nil
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	path := strings.Trim(strings.TrimPrefix(r.URL.Path, "/"+consts.VmaasCmpAPIBasePath+"/"), "/")
+	segments := strings.Split(path, "/")
+
+	body := make(map[string]interface{})
+	if r.Body != nil {
+		reqBytes, err := io.ReadAll(r.Body)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+
+			return
+		}
+		if len(bytes.TrimSpace(reqBytes)) > 0 {
+			if err := decode(reqBytes, &body); err != nil {
+				writeError(w, http.StatusBadRequest, err.Error())
+
+				return
+			}
+		}
+	}
+
+	if doc, ok := s.documents[path]; ok && r.Method == http.MethodGet {
+		writeJSON(w, http.StatusOK, doc)
+
+		return
+	}
+	// NSX integration refresh is invoked after creating NSX objects
+	if r.Method == http.MethodPost && strings.HasPrefix(path, "networks/servers/") &&
+		strings.HasSuffix(path, "/refresh") {
+		writeJSON(w, http.StatusOK, map[string]interface{}{"success": true})
+
+		return
+	}
+	if s.serveInstances(w, r.Method, segments, body) {
+		return
+	}
+
+	c, collectionPath, id, ok := matchCollection(segments)
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("%s %s is not supported by the mock", r.Method, path))
+
+		return
+	}
+	if id == "" {
+		s.serveCollection(w, r, c, collectionPath, body)
+	} else {
+		s.serveItem(w, r.Method, c, collectionPath, id, body)
+	}
+}
+
+func (s *Server) serveCollection(
+	w http.ResponseWriter,
+	r *http.Request,
+	c collection,
+	collectionPath string,
+	body map[string]interface{},
+) {
+	switch r.Method {
+	case http.MethodGet:
+		items := filterItems(s.store[collectionPath], r)
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			c.list: items,
+			"meta": map[string]interface{}{
+				"offset": 0,
+				"size":   len(items),
+				"total":  len(items),
+			},
+		})
+	case http.MethodPost:
+		item := s.create(c, collectionPath, unwrap(body, c.item))
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"success": true,
+			"id":      item["id"],
+			c.item:    item,
+		})
+	default:
+		writeError(w, http.StatusMethodNotAllowed, r.Method+" is not allowed on "+collectionPath)
+	}
+}
+
+func (s *Server) serveItem(
+	w http.ResponseWriter,
+	method string,
+	c collection,
+	collectionPath, id string,
+	body map[string]interface{},
+) {
+	item := s.find(collectionPath, id)
+	if item == nil {
+		writeNotFound(w, c.item, id)
+
+		return
+	}
+
+	switch method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, map[string]interface{}{c.item: item})
+	case http.MethodPut:
+		merge(item, unwrap(body, c.item))
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"success": true,
+			"id":      item["id"],
+			c.item:    item,
+		})
+	case http.MethodDelete:
+		s.remove(collectionPath, id)
+		writeJSON(w, http.StatusOK, map[string]interface{}{"success": true})
+	default:
+		writeError(w, http.StatusMethodNotAllowed, method+" is not allowed on "+collectionPath)
+	}
+}
+
+// create assigns a new ID for item and stores it in the collection
+func (s *Server) create(c collection, collectionPath string, item map[string]interface{}) map[string]interface{} {
+	s.add(collectionPath, item)
+	if c.onCreate != nil {
+		c.onCreate(s, item)
+	}
+
+	return item
+}
+
+func (s *Server) newID() int {
+	s.nextID++
+
+	return s.nextID
+}
+
+func (s *Server) find(collectionPath, id string) map[string]interface{} {
+	for _, item := range s.store[collectionPath] {
+		if fmt.Sprint(item["id"]) == id {
+			return item
+		}
+	}
+
+	return nil
+}
+
+func (s *Server) remove(collectionPath, id string) {
+	items := s.store[collectionPath]
+	for i, item := range items {
+		if fmt.Sprint(item["id"]) == id {
+			s.store[collectionPath] = append(items[:i:i], items[i+1:]...)
+
+			return
+		}
+	}
+}
+
+// onNetworkCreate sets the port group, provider waits for the port group
+// to be available after creating a network
+func onNetworkCreate(s *Server, item map[string]interface{}) {
+	item["externalId"] = fmt.Sprintf("%s%v", portGroup, item["id"])
+	item["status"] = "ok"
+}
+
+// onRouterCreate sets the status and provider ID of NSX gateway
+func onRouterCreate(s *Server, item map[string]interface{}) {
+	gatewayType := "tier-1s"
+	routerType := s.find("network-router-types", fmt.Sprint(mapOf(item["type"])["id"]))
+	if routerType != nil {
+		// CMP returns the type along with the code and name
+		item["type"] = map[string]interface{}{
+			"id":   routerType["id"],
+			"code": routerType["code"],
+			"name": routerType["name"],
+		}
+		if strings.HasSuffix(fmt.Sprint(routerType["code"]), "tier0") {
+			gatewayType = "tier-0s"
+		}
+	}
+	item["providerId"] = fmt.Sprintf("/infra/%s/tf-mock-%v", gatewayType, item["id"])
+	item["status"] = "ok"
+}
+
+// matchCollection returns the collection for the path segments. id will be empty
+// if segments refers to the collection itself.
+func matchCollection(segments []string) (collection, string, string, bool) {
+	for _, c := range collections {
+		if matchPattern(strings.Split(c.pattern, "/"), segments) {
+			return c, strings.Join(segments, "/"), "", true
+		}
+	}
+	if len(segments) < 2 {
+		return collection{}, "", "", false
+	}
+	parent := segments[:len(segments)-1]
+	for _, c := range collections {
+		if matchPattern(strings.Split(c.pattern, "/"), parent) {
+			return c, strings.Join(parent, "/"), segments[len(segments)-1], true
+		}
+	}
+
+	return collection{}, "", "", false
+}
+
+func matchPattern(pattern, segments []string) bool {
+	if len(pattern) != len(segments) {
+		return false
+	}
+	for i := range pattern {
+		if pattern[i] != idSegment && pattern[i] != segments[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// filterItems filters items with the query params supported by CMP. Matching is
+// case-insensitive, same as CMP
+func filterItems(items []map[string]interface{}, r *http.Request) []map[string]interface{} {
+	query := r.URL.Query()
+	filtered := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		matched := true
+		for _, key := range []string{"name", "externalName", "code"} {
+			if value := query.Get(key); value != "" && !strings.EqualFold(fmt.Sprint(item[key]), value) {
+				matched = false
+			}
+		}
+		if phrase := query.Get("phrase"); phrase != "" &&
+			!strings.Contains(strings.ToLower(fmt.Sprint(item["name"])), strings.ToLower(phrase)) {
+			matched = false
+		}
+		if matched {
+			filtered = append(filtered, item)
+		}
+	}
+
+	return filtered
+}
+
+// unwrap returns the object enveloped with key, CMP requests are wrapped with
+// the item key of the collection
+func unwrap(body map[string]interface{}, key string) map[string]interface{} {
+	if item, ok := body[key].(map[string]interface{}); ok {
+		return item
+	}
+	delete(body, "id")
+
+	return body
+}
+
+// merge updates dst with src recursively, ID is not updated
+func merge(dst, src map[string]interface{}) {
+	for k, v := range src {
+		if k == "id" {
+			continue
+		}
+		srcMap, srcOk := v.(map[string]interface{})
+		dstMap, dstOk := dst[k].(map[string]interface{})
+		if srcOk && dstOk {
+			merge(dstMap, srcMap)
+
+			continue
+		}
+		dst[k] = v
+	}
+}
+
+func mapOf(v interface{}) map[string]interface{} {
+	if m, ok := v.(map[string]interface{}); ok {
+		return m
+	}
+
+	return make(map[string]interface{})
+}
+
+func deepCopy(src map[string]interface{}) (map[string]interface{}, error) {
+	srcBytes, err := json.Marshal(src)
+	if err != nil {
+		return nil, err
+	}
+	dst := make(map[string]interface{})
+
+	return dst, decode(srcBytes, &dst)
+}
+
+// decode parses JSON with numbers as json.Number, so that IDs are retained as it is
+func decode(data []byte, v interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	return decoder.Decode(v)
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, v interface{}) {
+	w.Header().Set("Content-Type", consts.ContentType)
+	w.WriteHeader(statusCode)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Printf("[WARN] failed to write cmp mock response, error: %v", err)
+	}
+}
+
+func writeError(w http.ResponseWriter, statusCode int, msg string) {
+	writeJSON(w, statusCode, map[string]interface{}{
+		"success": false,
+		"msg":     msg,
+	})
+}
+
+func writeNotFound(w http.ResponseWriter, name, id string) {
+	writeError(w, http.StatusNotFound, fmt.Sprintf("%s with id %s not found", name, id))
+}
@@ -0,0 +1,195 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package cmpmock
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
+	pkgUtils "github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/utils"
+)
+
+func newTestClient(t *testing.T, s *Server) (*client.APIClient, client.Configuration) {
+	cfg := client.Configuration{
+		Host: s.URL(),
+		DefaultQueryParams: map[string]string{
+			"location": "mock",
+			"space":    "mock",
+		},
+	}
+	apiClient := client.NewAPIClient(&cfg)
+	if err := apiClient.SetMeta(nil, func(ctx *context.Context, meta interface{}) {}); err != nil {
+		t.Fatalf("SetMeta() error = %v", err)
+	}
+
+	return apiClient, cfg
+}
+
+func TestServer_lookup(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	apiClient, cfg := newTestClient(t, s)
+	ctx := context.Background()
+
+	cClient := client.CloudsAPIService{Client: apiClient, Cfg: cfg}
+	tests := []struct {
+		name    string
+		query   map[string]string
+		wantLen int
+	}{
+		{
+			name:    "Normal test case 1: match by name",
+			query:   map[string]string{"name": "HPE GreenLake VMaaS Cloud"},
+			wantLen: 1,
+		},
+		{
+			name:    "Normal test case 2: name is case insensitive",
+			query:   map[string]string{"name": "hpe greenlake vmaas cloud"},
+			wantLen: 1,
+		},
+		{
+			name:    "Normal test case 3: no match",
+			query:   map[string]string{"name": "unknown"},
+			wantLen: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clouds, err := cClient.GetAllClouds(ctx, tt.query)
+			if err != nil {
+				t.Fatalf("GetAllClouds() error = %v", err)
+			}
+			if len(clouds.Clouds) != tt.wantLen {
+				t.Errorf("GetAllClouds() returned %d clouds, want %d", len(clouds.Clouds), tt.wantLen)
+			}
+		})
+	}
+}
+
+func TestServer_router(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	apiClient, cfg := newTestClient(t, s)
+	ctx := context.Background()
+
+	rClient := client.RouterAPIService{Client: apiClient, Cfg: cfg}
+	createResp, err := rClient.CreateRouter(ctx, models.CreateRouterRequest{
+		NetworkRouter: models.CreateRouterRequestRouter{
+			Name: "tf_tier1",
+			Type: models.IDModel{ID: 32},
+		},
+	})
+	if err != nil || !createResp.Success {
+		t.Fatalf("CreateRouter() = %v, error = %v", createResp, err)
+	}
+
+	router, err := rClient.GetSpecificRouter(ctx, createResp.ID)
+	if err != nil {
+		t.Fatalf("GetSpecificRouter() error = %v", err)
+	}
+	if router.NetworkRouter.Name != "tf_tier1" || router.NetworkRouter.Status != "ok" {
+		t.Errorf("GetSpecificRouter() = %+v, want router tf_tier1 with status ok", router.NetworkRouter)
+	}
+
+	if _, err := rClient.DeleteRouter(ctx, createResp.ID); err != nil {
+		t.Fatalf("DeleteRouter() error = %v", err)
+	}
+	_, err = rClient.GetSpecificRouter(ctx, createResp.ID)
+	if statusCode := pkgUtils.GetStatusCode(err); statusCode != http.StatusNotFound {
+		t.Errorf("GetSpecificRouter() after delete returned status code %d, want %d", statusCode, http.StatusNotFound)
+	}
+}
+
+func TestServer_instance(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	apiClient, cfg := newTestClient(t, s)
+	ctx := context.Background()
+
+	iClient := client.InstancesAPIService{Client: apiClient, Cfg: cfg}
+	sClient := client.ServersAPIService{Client: apiClient, Cfg: cfg}
+	createResp, err := iClient.CreateAnInstance(ctx, &models.CreateInstanceBody{
+		ZoneID: "1",
+		Instance: &models.CreateInstanceBodyInstance{
+			Name:         "tf_acc_instance",
+			InstanceType: &models.CreateInstanceBodyInstanceInstanceType{Code: "vmware"},
+			Plan:         &models.CreateInstanceBodyInstancePlan{ID: "216"},
+			Layout:       &models.CreateInstanceBodyInstanceLayout{ID: "118"},
+			Site:         &models.CreateInstanceBodyInstanceSite{ID: 2},
+		},
+		Volumes: []models.CreateInstanceBodyVolumes{{Name: "root_vol", Size: 5, RootVolume: true}},
+		NetworkInterfaces: []models.CreateInstanceBodyNetworkInterfaces{
+			{Network: &models.CreateInstanceBodyNetwork{ID: 156}},
+		},
+		Config: &models.CreateInstanceBodyConfig{Template: 1044, ResourcePoolID: 5},
+	})
+	if err != nil {
+		t.Fatalf("CreateAnInstance() error = %v", err)
+	}
+	instanceID := createResp.Instance.ID
+
+	instance, err := iClient.GetASpecificInstance(ctx, instanceID)
+	if err != nil {
+		t.Fatalf("GetASpecificInstance() error = %v", err)
+	}
+	if instance.Instance.Status != "running" || len(instance.Instance.Volumes) != 1 ||
+		instance.Instance.Volumes[0].ID == 0 {
+		t.Errorf("GetASpecificInstance() = %+v, want running instance with a volume", instance.Instance)
+	}
+
+	servers, err := sClient.GetAllServers(ctx, map[string]string{"externalName": "tf_acc_instance"})
+	if err != nil {
+		t.Fatalf("GetAllServers() error = %v", err)
+	}
+	if len(servers.Server) != 1 || len(servers.Server[0].Interfaces) != 1 {
+		t.Fatalf("GetAllServers() = %+v, want a server with an interface", servers.Server)
+	}
+
+	snapshotResp, err := iClient.SnapshotAnInstance(ctx, instanceID, &models.SnapshotBody{
+		Snapshot: &models.SnapshotBodySnapshot{Name: "snap1"},
+	})
+	if err != nil || !snapshotResp.Success {
+		t.Fatalf("SnapshotAnInstance() = %v, error = %v", snapshotResp, err)
+	}
+	snapshots, err := iClient.GetListOfSnapshotsForAnInstance(ctx, instanceID)
+	if err != nil || len(snapshots.Snapshots) != 1 || snapshots.Snapshots[0].Name != "snap1" {
+		t.Errorf("GetListOfSnapshotsForAnInstance() = %+v, error = %v", snapshots, err)
+	}
+	history, err := iClient.GetInstanceHistory(ctx, instanceID)
+	if err != nil || len(history.Processes) != 2 {
+		t.Errorf("GetInstanceHistory() = %+v, error = %v", history, err)
+	}
+
+	if _, err := iClient.DeleteAnInstance(ctx, instanceID); err != nil {
+		t.Fatalf("DeleteAnInstance() error = %v", err)
+	}
+	_, err = iClient.GetASpecificInstance(ctx, instanceID)
+	if statusCode := pkgUtils.GetStatusCode(err); statusCode != http.StatusNotFound {
+		t.Errorf("GetASpecificInstance() after delete returned status code %d, want %d", statusCode, http.StatusNotFound)
+	}
+	servers, err = sClient.GetAllServers(ctx, map[string]string{"externalName": "tf_acc_instance"})
+	if err != nil || len(servers.Server) != 0 {
+		t.Errorf("GetAllServers() after delete = %+v, error = %v", servers.Server, err)
+	}
+}
+
+func TestServer_instanceWithoutTemplate(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	apiClient, cfg := newTestClient(t, s)
+
+	iClient := client.InstancesAPIService{Client: apiClient, Cfg: cfg}
+	_, err := iClient.CreateAnInstance(context.Background(), &models.CreateInstanceBody{
+		Instance: &models.CreateInstanceBodyInstance{
+			Name:         "tf_acc_instance",
+			InstanceType: &models.CreateInstanceBodyInstanceInstanceType{Code: "vmware"},
+		},
+		Config: &models.CreateInstanceBodyConfig{},
+	})
+	if statusCode := pkgUtils.GetStatusCode(err); statusCode != http.StatusBadRequest {
+		t.Errorf("CreateAnInstance() returned status code %d, want %d", statusCode, http.StatusBadRequest)
+	}
+}
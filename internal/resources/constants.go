@@ -1,7 +1,9 @@
-// (C) Copyright 2021-2022 Hewlett Packard Enterprise Development LP
+// (C) Copyright 2021-2024 Hewlett Packard Enterprise Development LP
 
 package resources
 
+import "time"
+
 const (
 	// datasource key
 	DSNetwork                = "hpegl_vmaas_network"
@@ -35,7 +37,10 @@ const (
 	// resource key
 	ResInstance                   = "hpegl_vmaas_instance"
 	ResInstanceClone              = "hpegl_vmaas_instance_clone"
+	ResInstanceSnapshot           = "hpegl_vmaas_instance_snapshot"
 	ResNetwork                    = "hpegl_vmaas_network"
+	ResNetworkPool                = "hpegl_vmaas_network_pool"
+	ResIPAddress                  = "hpegl_vmaas_ip_address"
 	ResRouter                     = "hpegl_vmaas_router"
 	ResLoadBalancer               = "hpegl_vmaas_load_balancer"
 	ResLoadBalancerMonitors       = "hpegl_vmaas_load_balancer_monitor"
@@ -44,9 +49,27 @@ const (
 	ResLoadBalancerVirtualServers = "hpegl_vmaas_load_balancer_virtual_server"
 	ResRouterNat                  = "hpegl_vmaas_router_nat_rule"
 	ResRouterFirewallRuleGroup    = "hpegl_vmaas_router_firewall_rule_group"
+	ResRouterFirewallRule         = "hpegl_vmaas_router_firewall_rule"
 	ResRouterRoute                = "hpegl_vmaas_router_route"
 	ResRouterBgpNeighbor          = "hpegl_vmaas_router_bgp_neighbor"
+	ResRouterInterface            = "hpegl_vmaas_router_interface"
+	ResRouterVpnService           = "hpegl_vmaas_router_vpn_service"
+	ResRouterVpnLocalEndpoint     = "hpegl_vmaas_router_vpn_local_endpoint"
+	ResRouterVpnIkeProfile        = "hpegl_vmaas_router_vpn_ike_profile"
+	ResRouterVpnIpsecProfile      = "hpegl_vmaas_router_vpn_ipsec_profile"
+	ResRouterVpnDpdProfile        = "hpegl_vmaas_router_vpn_dpd_profile"
+	ResRouterVpnSession           = "hpegl_vmaas_router_vpn_session"
+	ResSecurityGroup              = "hpegl_vmaas_security_group"
+	ResDistributedFirewallPolicy  = "hpegl_vmaas_distributed_firewall_policy"
 	ResDhcpServer                 = "hpegl_vmaas_dhcp_server"
+	ResDhcpStaticBinding          = "hpegl_vmaas_dhcp_static_binding"
+	ResDhcpRelay                  = "hpegl_vmaas_dhcp_relay"
+	ResCertificate                = "hpegl_vmaas_certificate"
+
+	// default timeouts for the resources
+	defaultTimeout         = 30 * time.Minute
+	instanceDefaultTimeout = 2 * time.Hour
+	networkDefaultTimeout  = 10 * time.Minute
 
 	// documentation related constants
 	generalNamedesc = "Name of the %s as it appears on HPE GreenLake for private cloud dashboard. " +
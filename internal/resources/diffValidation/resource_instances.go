@@ -52,6 +52,59 @@ func (i *Instance) DiffValidate() error {
 		return err
 	}
 
+	if err := i.instanceValidateNetworkAddressing(); err != nil {
+		return err
+	}
+
+	if err := i.instanceValidateRevertSnapshot(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// instanceValidateRevertSnapshot validates revert_snapshot is not set while
+// creating the instance, since revert works only on pre-created instance
+func (i *Instance) instanceValidateRevertSnapshot() error {
+	if i.diff.Id() != "" {
+		return nil
+	}
+	// revert_snapshot will be unknown if it is referred from another resource
+	if !i.diff.NewValueKnown("revert_snapshot") || i.diff.Get("revert_snapshot").(string) != "" {
+		return fmt.Errorf("revert_snapshot is not supported while creating the instance. " +
+			"Please set it once the instance is created")
+	}
+
+	return nil
+}
+
+// instanceValidateNetworkAddressing validates ip_address is set only for the
+// static interfaces
+func (i *Instance) instanceValidateNetworkAddressing() error {
+	networks, _ := i.diff.Get("network").([]interface{})
+	for idx, n := range networks {
+		network, ok := n.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		// ip_mode and ip_address will be unknown if it is referred from another resource
+		if !i.diff.NewValueKnown(fmt.Sprintf("network.%d.ip_mode", idx)) ||
+			!i.diff.NewValueKnown(fmt.Sprintf("network.%d.ip_address", idx)) {
+			continue
+		}
+
+		ipAddress := network["ip_address"].(string)
+		if network["ip_mode"].(string) == utils.IPModeStatic {
+			if ipAddress == "" {
+				return fmt.Errorf("ip_address is required for network %d, since ip_mode is '%s'",
+					idx, utils.IPModeStatic)
+			}
+		} else if ipAddress != "" {
+			return fmt.Errorf("ip_address of network %d is allowed only if ip_mode is '%s'",
+				idx, utils.IPModeStatic)
+		}
+	}
+
 	return nil
 }
 
@@ -5,6 +5,7 @@ package diffvalidation
 import (
 	"fmt"
 
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -24,8 +25,22 @@ const (
 
 	CookieProfile = "cookie_persistence_profile"
 	SourceProfile = "sourceip_persistence_profile"
+
+	vsRules = "rule"
 )
 
+// requiredRuleActionFields are the fields required for each type of the rule action
+var requiredRuleActionFields = map[string][]string{
+	"LBSelectPoolAction":                {"pool"},
+	"LBHttpRedirectAction":              {"redirect_url", "redirect_status"},
+	"LBHttpRejectAction":                {"reply_status"},
+	"LBHttpRequestUriRewriteAction":     {"uri"},
+	"LBHttpRequestHeaderRewriteAction":  {"header_name", "header_value"},
+	"LBHttpResponseHeaderRewriteAction": {"header_name", "header_value"},
+	"LBHttpRequestHeaderDeleteAction":   {"header_name"},
+	"LBHttpResponseHeaderDeleteAction":  {"header_name"},
+}
+
 type LoadBalancerVirtualServers struct {
 	diff *schema.ResourceDiff
 }
@@ -79,5 +94,37 @@ func (l *LoadBalancerVirtualServers) DiffValidate() error {
 		}
 	}
 
+	return l.validateRules(types)
+}
+
+func (l *LoadBalancerVirtualServers) validateRules(vsType interface{}) error {
+	rules, ok := l.diff.Get(vsRules).([]interface{})
+	if !ok || len(rules) == 0 {
+		return nil
+	}
+	if vsType != http {
+		return fmt.Errorf("rule is supported only for Type %s", http)
+	}
+
+	for i, r := range rules {
+		rule, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		actions, _ := rule["action"].([]interface{})
+		for _, a := range actions {
+			action, ok := a.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			actionType, _ := action["type"].(string)
+			for _, field := range requiredRuleActionFields[actionType] {
+				if utils.IsEmpty(action[field]) {
+					return fmt.Errorf("please provide %s for action %s in rule %d", field, actionType, i)
+				}
+			}
+		}
+	}
+
 	return nil
 }
@@ -11,6 +11,8 @@ import (
 const (
 	dhcpNetwork   = "dhcp_network"
 	isDhcpEnabled = "dhcp_enabled"
+	dhcpTypeLocal = "dhcpLocal"
+	dhcpTypeRelay = "dhcpRelay"
 )
 
 type Network struct {
@@ -41,6 +43,33 @@ func (l *Network) validateNetworks() error {
 		}
 	}
 
+	return l.validateDhcpType()
+}
+
+// validateDhcpType validates the DHCP range and the lease time, which are configured
+// on the DHCP server of the segment. DHCP relay forwards the requests to the external
+// DHCP servers, hence these are not applicable for the relay
+func (l *Network) validateDhcpType() error {
+	rangeKey := dhcpNetwork + ".0.dhcp_range"
+	leaseKey := dhcpNetwork + ".0.dhcp_lease_time"
+	// values from other resources are not known until apply
+	if !l.diff.NewValueKnown(rangeKey) || !l.diff.NewValueKnown(leaseKey) {
+		return nil
+	}
+
+	dhcpRange := l.diff.Get(rangeKey)
+	leaseTime := l.diff.Get(leaseKey)
+	switch dhcpType := l.diff.Get(dhcpNetwork + ".0.dhcp_type"); dhcpType {
+	case dhcpTypeLocal:
+		if dhcpRange == "" || leaseTime == "" {
+			return fmt.Errorf("dhcp_range and dhcp_lease_time should be set for %s", dhcpType)
+		}
+	case dhcpTypeRelay:
+		if dhcpRange != "" || leaseTime != "" {
+			return fmt.Errorf("dhcp_range and dhcp_lease_time are not supported for %s", dhcpType)
+		}
+	}
+
 	return nil
 }
 
@@ -0,0 +1,58 @@
+//  (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package diffvalidation
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	interfaceTypeExternal = "EXTERNAL"
+	interfaceTypeService  = "SERVICE"
+	interfaceTypeLoopback = "LOOPBACK"
+)
+
+type RouterInterface struct {
+	diff *schema.ResourceDiff
+}
+
+func NewRouterInterfaceValidate(diff *schema.ResourceDiff) *RouterInterface {
+	return &RouterInterface{
+		diff: diff,
+	}
+}
+
+func (r *RouterInterface) DiffValidate() error {
+	return r.validateInterfaceType()
+}
+
+// validateInterfaceType validates the fields required for each type of the interface.
+// External and loopback interfaces are created on an edge node, external and service
+// interfaces are connected to a segment.
+func (r *RouterInterface) validateInterfaceType() error {
+	interfaceType := r.diff.Get("type")
+	edgeNodeSet := r.diff.Get("edge_node") != ""
+	segmentSet := r.diff.Get("segment_id") != 0
+
+	switch interfaceType {
+	case interfaceTypeExternal:
+		if !edgeNodeSet || !segmentSet {
+			return fmt.Errorf("edge_node and segment_id should be set for %s interface", interfaceType)
+		}
+	case interfaceTypeService:
+		if !segmentSet {
+			return fmt.Errorf("segment_id should be set for %s interface", interfaceType)
+		}
+	case interfaceTypeLoopback:
+		if !edgeNodeSet {
+			return fmt.Errorf("edge_node should be set for %s interface", interfaceType)
+		}
+		if segmentSet {
+			return fmt.Errorf("segment_id is not supported for %s interface", interfaceType)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+//  (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package diffvalidation
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	ikeVersion2          = "IKE_V2"
+	gcmAlgorithmPrefix   = "AES_GCM_"
+	dpdProbeModePeriodic = "PERIODIC"
+	dpdProbeModeOnDemand = "ON_DEMAND"
+	vpnSessionPolicy     = "POLICY_BASED"
+	vpnSessionRoute      = "ROUTE_BASED"
+)
+
+type RouterVpn struct {
+	diff *schema.ResourceDiff
+}
+
+func NewRouterVpnValidate(diff *schema.ResourceDiff) *RouterVpn {
+	return &RouterVpn{
+		diff: diff,
+	}
+}
+
+// IkeProfileDiffValidate validates the algorithms of IKE profile. AES GCM encryption
+// is supported only with IKE V2 and it provides the integrity, so that digest
+// algorithms are not allowed along with only AES GCM encryption
+func (r *RouterVpn) IkeProfileDiffValidate() error {
+	gcmOnly := true
+	hasGcm := false
+	for _, e := range r.diff.Get("encryption_algorithms").([]interface{}) {
+		if strings.HasPrefix(e.(string), gcmAlgorithmPrefix) {
+			hasGcm = true
+		} else {
+			gcmOnly = false
+		}
+	}
+
+	if hasGcm && r.diff.Get("ike_version") != ikeVersion2 {
+		return fmt.Errorf("AES_GCM encryption algorithms are supported only with %s", ikeVersion2)
+	}
+	if hasGcm && gcmOnly && len(r.diff.Get("digest_algorithms").([]interface{})) > 0 {
+		return fmt.Errorf("digest_algorithms should not be set along with only AES_GCM encryption algorithms")
+	}
+	if !gcmOnly && len(r.diff.Get("digest_algorithms").([]interface{})) == 0 {
+		return fmt.Errorf("digest_algorithms should be set for non AES_GCM encryption algorithms")
+	}
+
+	return nil
+}
+
+// DpdProfileDiffValidate validates the probe interval of DPD profile based on the probe mode
+func (r *RouterVpn) DpdProfileDiffValidate() error {
+	interval := r.diff.Get("dpd_probe_interval").(int)
+	switch mode := r.diff.Get("dpd_probe_mode"); mode {
+	case dpdProbeModePeriodic:
+		if interval < 3 || interval > 360 {
+			return fmt.Errorf("dpd_probe_interval should be between 3 and 360 seconds for %s probe mode", mode)
+		}
+	case dpdProbeModeOnDemand:
+		if interval < 1 || interval > 10 {
+			return fmt.Errorf("dpd_probe_interval should be between 1 and 10 seconds for %s probe mode", mode)
+		}
+	}
+
+	return nil
+}
+
+// SessionDiffValidate validates the fields required for each type of the session.
+// Policy based session requires rules and route based session requires tunnel interface
+func (r *RouterVpn) SessionDiffValidate() error {
+	rulesSet := len(r.diff.Get("rule").([]interface{})) > 0
+	tunnelSet := r.diff.Get("tunnel_interface_cidr") != ""
+
+	switch sessionType := r.diff.Get("type"); sessionType {
+	case vpnSessionPolicy:
+		if !rulesSet {
+			return fmt.Errorf("at least one rule should be set for %s session", sessionType)
+		}
+		if tunnelSet {
+			return fmt.Errorf("tunnel_interface_cidr is not supported for %s session", sessionType)
+		}
+	case vpnSessionRoute:
+		if !tunnelSet {
+			return fmt.Errorf("tunnel_interface_cidr should be set for %s session", sessionType)
+		}
+		if rulesSet {
+			return fmt.Errorf("rule is not supported for %s session", sessionType)
+		}
+	}
+
+	return nil
+}
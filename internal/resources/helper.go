@@ -3,10 +3,41 @@
 package resources
 
 import (
+	"context"
 	"fmt"
+
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/cmp"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // f for format
 func f(format string, val ...interface{}) string {
 	return fmt.Sprintf(format, val...)
 }
+
+// importContext returns import function for the resources which need more than
+// the resource ID to populate the state, such as instances and child resources
+// of a router. Resource client returned by getClient is expected to implement
+// cmp.Importer
+func importContext(getClient func(c *client.Client) cmp.Resource) schema.StateContextFunc {
+	return func(ctx context.Context, rd *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		c, err := client.GetClientFromMetaMap(meta)
+		if err != nil {
+			return nil, err
+		}
+
+		importer, ok := getClient(c).(cmp.Importer)
+		if !ok {
+			return nil, fmt.Errorf("import is not supported for this resource")
+		}
+
+		data := utils.NewData(rd)
+		if err := importer.Import(ctx, data, meta); err != nil {
+			return nil, err
+		}
+
+		return []*schema.ResourceData{rd}, nil
+	}
+}
@@ -0,0 +1,173 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package resources
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/validations"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func Certificate() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the certificate",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the certificate",
+			},
+			"certificate": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validations.ValidateCertificate,
+				Description: "PEM encoded certificate. Changing the certificate will create a new certificate, " +
+					"use `create_before_destroy` lifecycle to rotate the certificate without downtime",
+			},
+			"private_key": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Sensitive:        true,
+				ValidateDiagFunc: validations.ValidatePrivateKey,
+				Description:      "PEM encoded private key of the certificate",
+			},
+			"chain": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validations.ValidateCertificate,
+				Description:      "PEM encoded intermediate certificates. Chain is uploaded along with the certificate",
+			},
+			"common_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Common name of the certificate",
+			},
+			"expires_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Expiry time of the certificate in RFC3339 format",
+			},
+			"expired": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "`true` if the certificate is expired",
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+		ReadContext:   certificateReadContext,
+		CreateContext: certificateCreateContext,
+		UpdateContext: certificateUpdateContext,
+		DeleteContext: certificateDeleteContext,
+		CustomizeDiff: certificateCustomDiff,
+		Description: `Certificate resource facilitates uploading, updating and deleting SSL certificates.
+		ID of the certificate can be used as ssl_server_cert or ssl_client_cert of load balancer
+		virtual servers.`,
+	}
+}
+
+func certificateReadContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.Certificate.Read(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if rd.Id() != "" && rd.Get("expired").(bool) {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("certificate %s is expired", rd.Get("name")),
+			Detail: fmt.Sprintf("certificate is expired at %s, update the certificate to rotate it",
+				rd.Get("expires_at")),
+		}}
+	}
+
+	return nil
+}
+
+func certificateCreateContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.Certificate.Create(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return certificateReadContext(ctx, rd, meta)
+}
+
+func certificateUpdateContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.Certificate.Update(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return certificateReadContext(ctx, rd, meta)
+}
+
+func certificateDeleteContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.Certificate.Delete(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// certificateCustomDiff rejects expired certificates and sets the expiry details
+// of the new certificate on plan
+func certificateCustomDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChange("certificate") || !diff.NewValueKnown("certificate") {
+		return nil
+	}
+
+	x509Cert, err := utils.ParseCertificate(diff.Get("certificate").(string))
+	if err != nil {
+		return err
+	}
+	if time.Now().After(x509Cert.NotAfter) {
+		return fmt.Errorf("certificate is expired at %s", x509Cert.NotAfter.UTC().Format(time.RFC3339))
+	}
+
+	if err := diff.SetNew("common_name", x509Cert.Subject.CommonName); err != nil {
+		return err
+	}
+	if err := diff.SetNew("expires_at", x509Cert.NotAfter.UTC().Format(time.RFC3339)); err != nil {
+		return err
+	}
+
+	return diff.SetNew("expired", false)
+}
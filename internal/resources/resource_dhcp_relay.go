@@ -0,0 +1,119 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package resources
+
+import (
+	"context"
+
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/cmp"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/validations"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DhcpRelay() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"network_server_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "NSX-T Integration ID",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the DHCP relay",
+			},
+			"server_addresses": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validations.ValidateIPAddress,
+				},
+				Description: "IP addresses of the external DHCP servers, where the DHCP requests are forwarded",
+			},
+			"provider_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "Provider ID of the DHCP relay. Use the provider_id as `dhcp_server` of the " +
+					"`dhcp_network` while creating DHCP relay NSX-T Segment Network",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importContext(func(c *client.Client) cmp.Resource {
+				return c.CmpClient.DhcpRelay
+			}),
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+		ReadContext:   dhcpRelayReadContext,
+		CreateContext: dhcpRelayCreateContext,
+		UpdateContext: dhcpRelayUpdateContext,
+		DeleteContext: dhcpRelayDeleteContext,
+		Description: `DHCP relay resource facilitates creating, updating
+		and deleting NSX-T DHCP relays, which forward the DHCP requests to external DHCP servers.`,
+	}
+}
+
+func dhcpRelayReadContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.DhcpRelay.Read(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func dhcpRelayCreateContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.DhcpRelay.Create(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return dhcpRelayReadContext(ctx, rd, meta)
+}
+
+func dhcpRelayUpdateContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.DhcpRelay.Update(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return dhcpRelayReadContext(ctx, rd, meta)
+}
+
+func dhcpRelayDeleteContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.DhcpRelay.Delete(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,190 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package resources
+
+import (
+	"context"
+
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/cmp"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/validations"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DhcpStaticBinding() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"network_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+				Description: "ID of the segment, network_id can be obtained by using " + ResNetwork +
+					" resource. DHCP server should be configured on the segment using `dhcp_network`",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the DHCP static binding",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the DHCP static binding",
+			},
+			"mac_address": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validations.ValidateMacAddress,
+				Description:      "MAC address of the VM NIC, for example `00:50:56:aa:bb:cc`",
+			},
+			"ip_address": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validations.ValidateIPAddress,
+				Description: "IP address bound to the MAC address. IP address should be in the subnet of the " +
+					"segment and it should not overlap the DHCP range of the segment",
+			},
+			"hostname": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Host name assigned to the VM",
+			},
+			"lease_time": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          86400,
+				ValidateDiagFunc: validations.IntAtLeast(60),
+				Description:      "Lease time of the IP address in seconds",
+			},
+			"gateway_address": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validations.ValidateIPAddress,
+				Description:      "Default gateway of the VM. If not set, gateway of the segment is used",
+			},
+			"option121": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Classless static routes (DHCP option 121) of the VM",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"network": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validations.ValidateCidr,
+							Description:      "Destination network in CIDR format",
+						},
+						"next_hop": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validations.ValidateIPAddress,
+							Description:      "IP address of the next hop",
+						},
+					},
+				},
+			},
+			"other_option": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "DHCP options other than option 121",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"code": {
+							Type:             schema.TypeInt,
+							Required:         true,
+							ValidateDiagFunc: validations.IntBetween(2, 254),
+							Description:      "Code of the DHCP option",
+						},
+						"values": {
+							Type:        schema.TypeList,
+							Required:    true,
+							MinItems:    1,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Values of the DHCP option",
+						},
+					},
+				},
+			},
+			"provider_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "NSX-T path of the DHCP static binding",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importContext(func(c *client.Client) cmp.Resource {
+				return c.CmpClient.DhcpStaticBinding
+			}),
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+		ReadContext:   dhcpStaticBindingReadContext,
+		CreateContext: dhcpStaticBindingCreateContext,
+		UpdateContext: dhcpStaticBindingUpdateContext,
+		DeleteContext: dhcpStaticBindingDeleteContext,
+		Description: `DHCP static binding resource facilitates creating, updating
+		and deleting static MAC to IP bindings of the DHCP server of NSX-T segments.`,
+	}
+}
+
+func dhcpStaticBindingReadContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.DhcpStaticBinding.Read(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func dhcpStaticBindingCreateContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.DhcpStaticBinding.Create(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return dhcpStaticBindingReadContext(ctx, rd, meta)
+}
+
+func dhcpStaticBindingUpdateContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.DhcpStaticBinding.Update(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return dhcpStaticBindingReadContext(ctx, rd, meta)
+}
+
+func dhcpStaticBindingDeleteContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.DhcpStaticBinding.Delete(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,145 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package resources
+
+import (
+	"context"
+
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/cmp"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/schemas"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/validations"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DistributedFirewallPolicy() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"network_server_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "ID of the NSX-T network server which owns the policy",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the distributed firewall policy",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the distributed firewall policy",
+			},
+			"category": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "Application",
+				ValidateDiagFunc: validations.StringInSlice([]string{
+					"Ethernet", "Emergency", "Infrastructure", "Environment", "Application",
+				}, false),
+				Description: "Category of the policy. Supported values are `Ethernet`, `Emergency`, " +
+					"`Infrastructure`, `Environment` and `Application`",
+			},
+			"priority": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          100,
+				ValidateDiagFunc: validations.IntAtLeast(0),
+				Description:      "Priority of the policy within the category. Lower value has the higher priority",
+			},
+			"stateful": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+				Description: "If `true` then the rules of the policy are stateful",
+			},
+			"rule": schemas.DfwRuleSchema(),
+			"external_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "NSX-T path of the distributed firewall policy",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importContext(func(c *client.Client) cmp.Resource {
+				return c.CmpClient.DistributedFirewallPolicy
+			}),
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+		ReadContext:   distributedFirewallPolicyReadContext,
+		CreateContext: distributedFirewallPolicyCreateContext,
+		UpdateContext: distributedFirewallPolicyUpdateContext,
+		DeleteContext: distributedFirewallPolicyDeleteContext,
+		Description: `Distributed firewall policy resource facilitates creating, updating
+		and deleting NSX-T distributed firewall policies along with the ordered rules, which
+		control the traffic between the workloads including the workloads on the same segment.`,
+	}
+}
+
+func distributedFirewallPolicyReadContext(ctx context.Context, rd *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.DistributedFirewallPolicy.Read(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func distributedFirewallPolicyCreateContext(ctx context.Context, rd *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.DistributedFirewallPolicy.Create(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return distributedFirewallPolicyReadContext(ctx, rd, meta)
+}
+
+func distributedFirewallPolicyUpdateContext(ctx context.Context, rd *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.DistributedFirewallPolicy.Update(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return distributedFirewallPolicyReadContext(ctx, rd, meta)
+}
+
+func distributedFirewallPolicyDeleteContext(ctx context.Context, rd *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.DistributedFirewallPolicy.Delete(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
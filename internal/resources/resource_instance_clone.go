@@ -26,11 +26,14 @@ func InstancesClone() *schema.Resource {
 	For creating an instance clone, provide a unique name and all the Mandatory(Required) parameters.
 	All optional parameters will be inherited from parent resource if not provided.`
 
-	instanceCloneSchema.CreateWithoutTimeout = instanceCloneCreateContext
-	instanceCloneSchema.ReadWithoutTimeout = instanceCloneReadContext
-	instanceCloneSchema.UpdateWithoutTimeout = instanceCloneUpdateContext
-	instanceCloneSchema.DeleteWithoutTimeout = instanceCloneDeleteContext
+	instanceCloneSchema.CreateContext = instanceCloneCreateContext
+	instanceCloneSchema.ReadContext = instanceCloneReadContext
+	instanceCloneSchema.UpdateContext = instanceCloneUpdateContext
+	instanceCloneSchema.DeleteContext = instanceCloneDeleteContext
 	instanceCloneSchema.CustomizeDiff = instanceCustomizeDiff
+	instanceCloneSchema.Importer = &schema.ResourceImporter{
+		StateContext: importContext((&instanceCloneResourceObj{}).getClient),
+	}
 
 	return instanceCloneSchema
 }
@@ -0,0 +1,114 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package resources
+
+import (
+	"context"
+
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/cmp"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func InstanceSnapshot() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Parent instance ID, instance_id can be obtained by using instance/instance clone resource.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the snapshot. Name should be unique for an instance.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Description of the snapshot.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the snapshot.",
+			},
+			"external_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "External ID of the snapshot.",
+			},
+			"date_created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date and time of the snapshot creation.",
+			},
+			"currently_active": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "If `true` then the snapshot is the current snapshot of the instance.",
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+		ReadContext:   instanceSnapshotReadContext,
+		CreateContext: instanceSnapshotCreateContext,
+		DeleteContext: instanceSnapshotDeleteContext,
+		Importer: &schema.ResourceImporter{
+			StateContext: importContext(func(c *client.Client) cmp.Resource {
+				return c.CmpClient.InstanceSnapshot
+			}),
+		},
+		Description: `Instance snapshot resource facilitates creating and deleting
+		snapshots of an instance. Multiple snapshots can be created for an instance.`,
+	}
+}
+
+func instanceSnapshotReadContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.InstanceSnapshot.Read(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func instanceSnapshotCreateContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.InstanceSnapshot.Create(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return instanceSnapshotReadContext(ctx, rd, meta)
+}
+
+func instanceSnapshotDeleteContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.InstanceSnapshot.Delete(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
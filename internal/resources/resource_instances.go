@@ -46,11 +46,14 @@ func Instances() *schema.Resource {
 	}
 	instanceSchema.Description = `This Instance resource facilitates creating,
 		updating and deleting virtual machines. HPE recommends that you use the VMware as type for provisioning.`
-	instanceSchema.CreateWithoutTimeout = instanceCreateContext
-	instanceSchema.ReadWithoutTimeout = instanceReadContext
-	instanceSchema.DeleteWithoutTimeout = instanceDeleteContext
-	instanceSchema.UpdateWithoutTimeout = instanceUpdateContext
+	instanceSchema.CreateContext = instanceCreateContext
+	instanceSchema.ReadContext = instanceReadContext
+	instanceSchema.DeleteContext = instanceDeleteContext
+	instanceSchema.UpdateContext = instanceUpdateContext
 	instanceSchema.CustomizeDiff = instanceCustomizeDiff
+	instanceSchema.Importer = &schema.ResourceImporter{
+		StateContext: importContext((&instanceResourceObj{}).getClient),
+	}
 
 	return instanceSchema
 }
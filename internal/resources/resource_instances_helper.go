@@ -8,6 +8,7 @@ import (
 
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/cmp"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/schemas"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/validations"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -18,7 +19,6 @@ import (
 
 const (
 	// update
-	instanceUpdateRetryTimeout    = 10 * time.Minute
 	instanceUpdateRetryDelay      = 15 * time.Second
 	instanceUpdateRetryMinTimeout = 15 * time.Second
 )
@@ -40,6 +40,71 @@ func getInstanceDefaultSchema(isClone bool) *schema.Resource {
 		layoutID.Required = true
 	}
 
+	instanceConfig := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"resource_pool_id": {
+				Type:        schema.TypeInt,
+				Optional:    isClone,
+				Required:    !isClone,
+				Description: f(generalDDesc, "resource pool"),
+			},
+			"template_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Unique ID for the template",
+			},
+			"no_agent": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "If true agent will not be installed on the instance.",
+			},
+			"folder_code": {
+				Type:        schema.TypeString,
+				Optional:    isClone,
+				Required:    !isClone,
+				Description: "Folder in which all VMs to be spawned, use hpegl_vmaas_cloud_folder.code datasource",
+			},
+			"asset_tag": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Asset tag",
+			},
+			"create_user": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Create user",
+				ForceNew:    true,
+			},
+			"user_data": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description: `Cloud-init user data or guest customization script to be executed
+				on the first boot of the instance. Use templatefile function for templated user data.`,
+			},
+			"ssh_public_keys": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+				Description: "SSH public keys to be added to the authorized keys of the instance.",
+			},
+			"domain_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Domain name of the instance, which is set along with the hostname on guest customization.",
+			},
+			"workflow_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "ID of the workflow to be executed after provisioning the instance.",
+			},
+		},
+	}
+
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"server_id": {
@@ -99,6 +164,23 @@ func getInstanceDefaultSchema(isClone bool) *schema.Resource {
 							Optional:    true,
 							Description: f(generalDDesc, "network interface type"),
 						},
+						"ip_mode": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  utils.IPModeDhcp,
+							ValidateFunc: validation.StringInSlice([]string{
+								utils.IPModeDhcp, utils.IPModeStatic, utils.IPModePool,
+							}, false),
+							Description: "IP addressing mode of the interface. Supported values are `dhcp`, `static` " +
+								"and `pool`. `pool` allocates the IP address from the IP pool of the network.",
+						},
+						"ip_address": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: validations.ValidateIPAddress,
+							Description: "Static IP address of the interface. Required only if `ip_mode` is `static`. " +
+								"IP address can be reserved using " + ResIPAddress + " resource.",
+						},
 						"is_primary": {
 							Type:        schema.TypeBool,
 							Computed:    true,
@@ -186,43 +268,8 @@ func getInstanceDefaultSchema(isClone bool) *schema.Resource {
 				Optional:    isClone,
 				Required:    !isClone,
 				Description: "Configuration details for the instance to be provisioned.",
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"resource_pool_id": {
-							Type:        schema.TypeInt,
-							Optional:    isClone,
-							Required:    !isClone,
-							Description: f(generalDDesc, "resource pool"),
-						},
-						"template_id": {
-							Type:        schema.TypeInt,
-							Optional:    true,
-							Description: "Unique ID for the template",
-						},
-						"no_agent": {
-							Type:        schema.TypeBool,
-							Optional:    true,
-							Description: "If true agent will not be installed on the instance.",
-						},
-						"folder_code": {
-							Type:        schema.TypeString,
-							Optional:    isClone,
-							Required:    !isClone,
-							Description: "Folder in which all VMs to be spawned, use hpegl_vmaas_cloud_folder.code datasource",
-						},
-						"asset_tag": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "Asset tag",
-						},
-						"create_user": {
-							Type:        schema.TypeBool,
-							Optional:    true,
-							Description: "Create user",
-							ForceNew:    true,
-						},
-					},
-				},
+				Elem:        instanceConfig,
+				Set:         instanceConfigHash(instanceConfig),
 			},
 			"scale": {
 				Type:        schema.TypeInt,
@@ -316,14 +363,31 @@ func getInstanceDefaultSchema(isClone bool) *schema.Resource {
 					},
 				},
 			},
+			"revert_snapshot": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: `Name of the snapshot to which the instance should be reverted. Instance will be
+				reverted whenever this value or revert_trigger is changed. Revert works only on
+				pre-created instance.`,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"revert_trigger": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Description: `Reverts the instance to revert_snapshot again if set to any positive integer.
+				Change this value to revert to the same snapshot again.`,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 			"history":    schemas.GetInstanceHistorySchema(),
 			"containers": schemas.GetInstanceContainerSchema(),
 		},
 		SchemaVersion:  0,
 		StateUpgraders: nil,
 		CustomizeDiff:  nil,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(instanceDefaultTimeout),
+			Update: schema.DefaultTimeout(instanceDefaultTimeout),
+			Delete: schema.DefaultTimeout(instanceDefaultTimeout),
 		},
 	}
 }
@@ -407,7 +471,7 @@ func instanceHelperUpdateContext(
 		Delay:      instanceUpdateRetryDelay,
 		Pending:    []string{utils.StateResizing, utils.StateStopping, utils.StateSuspending, utils.StateRestarting},
 		Target:     []string{utils.StateRunning, utils.StateStopped, utils.StateSuspended},
-		Timeout:    instanceUpdateRetryTimeout,
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
 		MinTimeout: instanceUpdateRetryMinTimeout,
 		Refresh: func() (result interface{}, state string, err error) {
 			if err := ro.getClient(c).Read(ctx, data, meta); err != nil {
@@ -424,3 +488,29 @@ func instanceHelperUpdateContext(
 
 	return instanceReadContext(ctx, d, meta)
 }
+
+// instanceGuestCustomizationKeys are the attributes of config which are applied only
+// on provisioning and are not returned by the API
+var instanceGuestCustomizationKeys = map[string]bool{
+	"user_data":       true,
+	"ssh_public_keys": true,
+	"domain_name":     true,
+	"workflow_id":     true,
+}
+
+// instanceConfigHash hashes config without the guest customization attributes. Since
+// config is ForceNew, changing these attributes or setting them on an imported instance
+// would otherwise plan to re-create the instance, though they have no effect on the
+// provisioned instance.
+func instanceConfigHash(config *schema.Resource) schema.SchemaSetFunc {
+	hashed := &schema.Resource{
+		Schema: make(map[string]*schema.Schema, len(config.Schema)),
+	}
+	for k, v := range config.Schema {
+		if !instanceGuestCustomizationKeys[k] {
+			hashed.Schema[k] = v
+		}
+	}
+
+	return schema.HashResource(hashed)
+}
@@ -0,0 +1,131 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package resources
+
+import (
+	"context"
+
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/cmp"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/validations"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func IPAddress() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"pool_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+				Description: "ID of the network pool, pool_id can be obtained by using " + ResNetworkPool +
+					" resource/datasource",
+			},
+			"ip_address": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validations.ValidateIPAddress,
+				Description: "IP address to be reserved. If not set, the first free IP address of the pool " +
+					"is allocated",
+			},
+			"hostname": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Host name of the IP address",
+			},
+			"gateway": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Gateway of the network pool",
+			},
+			"netmask": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Netmask of the network pool",
+			},
+			"dns_servers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "DNS servers of the network pool",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importContext(func(c *client.Client) cmp.Resource {
+				return c.CmpClient.IPAddress
+			}),
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+		ReadContext:   ipAddressReadContext,
+		CreateContext: ipAddressCreateContext,
+		UpdateContext: ipAddressUpdateContext,
+		DeleteContext: ipAddressDeleteContext,
+		Description: `IP address resource facilitates allocating IP addresses from the network pool
+		and releasing them to the pool on destroy. Allocated IP address can be used as the static IP
+		address of the instance network interface.`,
+	}
+}
+
+func ipAddressReadContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.IPAddress.Read(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func ipAddressCreateContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.IPAddress.Create(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ipAddressReadContext(ctx, rd, meta)
+}
+
+func ipAddressUpdateContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.IPAddress.Update(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ipAddressReadContext(ctx, rd, meta)
+}
+
+func ipAddressDeleteContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.IPAddress.Delete(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ package resources
 import (
 	"context"
 
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/cmp"
 	diffvalidation "github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/diffValidation"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/schemas"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/validations"
@@ -53,6 +54,16 @@ func LoadBalancerMonitor() *schema.Resource {
 			"tcp_monitor":     schemas.TCPMonitorSchema(),
 			"udp_monitor":     schemas.UDPMonitorSchema(),
 		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importContext(func(c *client.Client) cmp.Resource {
+				return c.CmpClient.LoadBalancerMonitor
+			}),
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
 		ReadContext:   loadbalancerMonitorReadContext,
 		UpdateContext: loadbalancerMonitorUpdateContext,
 		CreateContext: loadbalancerMonitorCreateContext,
@@ -5,6 +5,7 @@ package resources
 import (
 	"context"
 
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/cmp"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/validations"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
@@ -54,6 +55,8 @@ func LoadBalancerPools() *schema.Resource {
 			"config": {
 				Type:        schema.TypeList,
 				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
 				Description: "pool Configuration",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -128,6 +131,58 @@ func LoadBalancerPools() *schema.Resource {
 								},
 							},
 						},
+						"member": {
+							Type:          schema.TypeList,
+							Optional:      true,
+							ConflictsWith: []string{"config.0.member_group"},
+							Description:   "Static pool members. Members can be any backend, which is reachable from the load balancer",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Name of the pool member",
+									},
+									"ip_address": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: validations.ValidateIPAddress,
+										Description:      "IP address of the pool member",
+									},
+									"port": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										Description: "Port of the pool member. If not set, the traffic will be " +
+											"transferred to the port of the virtual server",
+									},
+									"weight": {
+										Type:             schema.TypeInt,
+										Optional:         true,
+										Default:          1,
+										ValidateDiagFunc: validations.IntBetween(1, 256),
+										Description: "Weight of the pool member. Applicable only for `WEIGHTED_ROUND_ROBIN` " +
+											"and `WEIGHTED_LEAST_CONNECTION` algorithms",
+									},
+									"admin_state": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  "ENABLED",
+										ValidateDiagFunc: validations.StringInSlice([]string{
+											"ENABLED", "DISABLED", "GRACEFUL_DISABLED",
+										}, false),
+										Description: "Admin state of the pool member. Supported values are `ENABLED`, " +
+											"`DISABLED` and `GRACEFUL_DISABLED`",
+									},
+									"backup_member": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+										Description: "If `true` then the member will receive the traffic only when " +
+											"all other members are down",
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -151,6 +206,16 @@ func LoadBalancerPools() *schema.Resource {
 				},
 			},
 		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importContext(func(c *client.Client) cmp.Resource {
+				return c.CmpClient.LoadBalancerPool
+			}),
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
 		ReadContext:   loadbalancerPoolReadContext,
 		UpdateContext: loadbalancerPoolUpdateContext,
 		CreateContext: loadbalancerPoolCreateContext,
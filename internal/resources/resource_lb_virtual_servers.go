@@ -5,6 +5,7 @@ package resources
 import (
 	"context"
 
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/cmp"
 	diffvalidation "github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/diffValidation"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/schemas"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/validations"
@@ -78,7 +79,7 @@ func LoadBalancerVirtualServers() *schema.Resource {
 				Type:     schema.TypeInt,
 				Required: true,
 				Description: "ssl_server_cert Id, Get the `id` from " + DSLBVirtualServerSslCert +
-					" datasource to obtain the ssl_server_cert Id, " +
+					" datasource or " + ResCertificate + " resource to obtain the ssl_server_cert Id, " +
 					"SSLServerCert is needed only for https based load balancer",
 			},
 			"ssl_server_config": {
@@ -100,7 +101,7 @@ func LoadBalancerVirtualServers() *schema.Resource {
 				Type:     schema.TypeInt,
 				Required: true,
 				Description: "ssl_client_cert Id, Get the `id` from " + DSLBVirtualServerSslCert +
-					" datasource to obtain the ssl_client_cert Id, " +
+					" datasource or " + ResCertificate + " resource to obtain the ssl_client_cert Id, " +
 					"SSLClientCert is needed only for https based load balancer",
 			},
 			"ssl_client_config": {
@@ -118,6 +119,17 @@ func LoadBalancerVirtualServers() *schema.Resource {
 					},
 				},
 			},
+			"rule": schemas.VirtualServerRuleSchema(),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importContext(func(c *client.Client) cmp.Resource {
+				return c.CmpClient.LoadBalancerVirtualServer
+			}),
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
 		},
 		ReadContext:   loadbalancerVirtualServerReadContext,
 		UpdateContext: loadbalancerVirtualServerUpdateContext,
@@ -113,6 +113,11 @@ func LoadBalancer() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
 		ReadContext:   LoadbalancerReadContext,
 		UpdateContext: LoadbalancerUpdateContext,
 		CreateContext: LoadbalancerCreateContext,
@@ -0,0 +1,139 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package resources
+
+import (
+	"context"
+
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/validations"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func NetworkPool() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the network pool",
+			},
+			"ip_range": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "IP address ranges of the network pool",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"start_address": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validations.ValidateIPAddress,
+							Description:      "First IP address of the range",
+						},
+						"end_address": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validations.ValidateIPAddress,
+							Description:      "Last IP address of the range",
+						},
+					},
+				},
+			},
+			"gateway": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validations.ValidateIPAddress,
+				Description:      "Gateway of the network pool. Gateway is never allocated from the pool",
+			},
+			"netmask": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validations.ValidateIPAddress,
+				Description:      "Netmask of the network pool, for example `255.255.255.0`",
+			},
+			"dns_servers": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validations.ValidateIPAddress,
+				},
+				Description: "DNS servers of the network pool",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+		ReadContext:   resNetworkPoolReadContext,
+		CreateContext: resNetworkPoolCreateContext,
+		UpdateContext: resNetworkPoolUpdateContext,
+		DeleteContext: resNetworkPoolDeleteContext,
+		Description: `Network pool resource facilitates creating, updating
+		and deleting IP pools managed by HPE GreenLake for private cloud. IP addresses can be
+		allocated from the pool using ` + ResIPAddress + ` resource.`,
+	}
+}
+
+func resNetworkPoolReadContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.ResNetworkPool.Read(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resNetworkPoolCreateContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.ResNetworkPool.Create(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resNetworkPoolReadContext(ctx, rd, meta)
+}
+
+func resNetworkPoolUpdateContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.ResNetworkPool.Update(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resNetworkPoolReadContext(ctx, rd, meta)
+}
+
+func resNetworkPoolDeleteContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.ResNetworkPool.Delete(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
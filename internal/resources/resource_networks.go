@@ -198,6 +198,11 @@ func Network() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(networkDefaultTimeout),
+			Update: schema.DefaultTimeout(networkDefaultTimeout),
+			Delete: schema.DefaultTimeout(networkDefaultTimeout),
+		},
 		ReadContext:   resNetworkReadContext,
 		CreateContext: resNetworkCreateContext,
 		UpdateContext: resNetworkUpdateContext,
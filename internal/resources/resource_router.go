@@ -77,6 +77,14 @@ func Router() *schema.Resource {
 			"tier0_config": schemas.RouterTier0ConfigSchema(),
 			"tier1_config": schemas.RouterTier1ConfigSchema(),
 		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
 		ReadContext:   routerReadContext,
 		CreateContext: routerCreateContext,
 		UpdateContext: routerUpdateContext,
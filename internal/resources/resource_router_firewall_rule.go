@@ -0,0 +1,176 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package resources
+
+import (
+	"context"
+
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/cmp"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/validations"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func RouterFirewallRule() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"router_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Parent router ID, router_id can be obtained by using router datasource/resource.",
+			},
+			"rule_group_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+				Description: "Parent firewall rule group ID, rule_group_id can be obtained by using " +
+					"router firewall rule group resource.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the firewall rule.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description for the firewall rule.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "If `true` then firewall rule will be active/enabled.",
+			},
+			"priority": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          100,
+				Description:      "Priority for the firewall rule",
+				ValidateDiagFunc: validations.IntAtLeast(1),
+			},
+			"action": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateDiagFunc: validations.StringInSlice([]string{
+					"ALLOW", "DROP", "REJECT",
+				}, false),
+				Description: "Action on the traffic matching the rule. Supported values are `ALLOW`, `DROP` and `REJECT`",
+			},
+			"direction": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "IN_OUT",
+				ValidateDiagFunc: validations.StringInSlice([]string{
+					"IN", "OUT", "IN_OUT",
+				}, false),
+				Description: "Direction of the traffic. Supported values are `IN`, `OUT` and `IN_OUT`",
+			},
+			"sources": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Source groups of the traffic. Rule matches any source if not set.",
+			},
+			"destinations": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Destination groups of the traffic. Rule matches any destination if not set.",
+			},
+			"services": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Services of the traffic. Rule matches any service if not set.",
+			},
+			"applied_to": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Router interfaces where the rule is applied. Rule is applied on the router if not set.",
+			},
+			"logging": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Enable/Disable Logging",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importContext(func(c *client.Client) cmp.Resource {
+				return c.CmpClient.RouterFirewallRule
+			}),
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+		ReadContext:   routerFirewallRuleReadContext,
+		CreateContext: routerFirewallRuleCreateContext,
+		UpdateContext: routerFirewallRuleUpdateContext,
+		DeleteContext: routerFirewallRuleDeleteContext,
+		Description: `Router firewall rule resource facilitates creating, updating
+		and deleting NSX-T gateway firewall rules of a router firewall rule group.`,
+	}
+}
+
+func routerFirewallRuleReadContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.RouterFirewallRule.Read(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func routerFirewallRuleCreateContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.RouterFirewallRule.Create(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return routerFirewallRuleReadContext(ctx, rd, meta)
+}
+
+func routerFirewallRuleUpdateContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.RouterFirewallRule.Update(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return routerFirewallRuleReadContext(ctx, rd, meta)
+}
+
+func routerFirewallRuleDeleteContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.RouterFirewallRule.Delete(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,164 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package resources
+
+import (
+	"context"
+
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/cmp"
+	diffvalidation "github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/diffValidation"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/validations"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func RouterInterface() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"router_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+				Description: "Parent router ID, router_id can be obtained by using router datasource/resource. " +
+					"Router should be a Tier-0 Gateway",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the interface",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the interface",
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateDiagFunc: validations.StringInSlice([]string{
+					"EXTERNAL", "SERVICE", "LOOPBACK",
+				}, false),
+				Description: "Type of the interface. Supported values are `EXTERNAL` (uplink), `SERVICE` and `LOOPBACK`",
+			},
+			"edge_node": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: "Path of the edge node on which the interface is created, for example " +
+					"`/infra/sites/default/enforcement-points/default/edge-clusters/<edge_cluster_id>/edge-nodes/0`. " +
+					"Required for `EXTERNAL` and `LOOPBACK` interfaces",
+			},
+			"segment_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+				Description: "ID of the segment to which the interface is connected, segment_id can be obtained " +
+					"by using network datasource/resource. Required for `EXTERNAL` and `SERVICE` interfaces",
+			},
+			"cidr": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validations.ValidateCidr,
+				Description:      "IP address of the interface along with the prefix length, for example `192.168.10.1/24`",
+			},
+			"mtu": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ValidateDiagFunc: validations.IntBetween(64, 9000),
+				Description:      "MTU of the interface. If not set, global MTU of the gateway is used",
+			},
+			"urpf_mode": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "STRICT",
+				ValidateDiagFunc: validations.StringInSlice([]string{"STRICT", "NONE"}, false),
+				Description:      "Unicast reverse path forwarding mode. Supported values are `STRICT` and `NONE`",
+			},
+			"provider_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "NSX-T path of the interface",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importContext(func(c *client.Client) cmp.Resource {
+				return c.CmpClient.RouterInterface
+			}),
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+		ReadContext:   routerInterfaceReadContext,
+		CreateContext: routerInterfaceCreateContext,
+		UpdateContext: routerInterfaceUpdateContext,
+		DeleteContext: routerInterfaceDeleteContext,
+		CustomizeDiff: routerInterfaceCustomDiff,
+		Description: `Router interface resource facilitates creating, updating
+		and deleting uplink, service and loopback interfaces of NSX-T Tier-0 Gateways.`,
+	}
+}
+
+func routerInterfaceReadContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.RouterInterface.Read(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func routerInterfaceCreateContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.RouterInterface.Create(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return routerInterfaceReadContext(ctx, rd, meta)
+}
+
+func routerInterfaceUpdateContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.RouterInterface.Update(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return routerInterfaceReadContext(ctx, rd, meta)
+}
+
+func routerInterfaceDeleteContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.RouterInterface.Delete(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func routerInterfaceCustomDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	return diffvalidation.NewRouterInterfaceValidate(diff).DiffValidate()
+}
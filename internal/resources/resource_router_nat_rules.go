@@ -5,6 +5,7 @@ package resources
 import (
 	"context"
 
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/cmp"
 	diffvalidation "github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/diffValidation"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/validations"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
@@ -103,9 +104,9 @@ func RouterNatRule() *schema.Resource {
 				Description:      "Translated Network CIDR/IPv4 Address",
 			},
 			"translated_ports": {
-				Type:        schema.TypeInt,
+				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "Translated Network Port",
+				Description: "Translated Network Port or port range, for example `22` or `8080-8090`",
 			},
 			"priority": {
 				Type:             schema.TypeInt,
@@ -115,6 +116,16 @@ func RouterNatRule() *schema.Resource {
 				ValidateDiagFunc: validations.IntAtLeast(1),
 			},
 		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importContext(func(c *client.Client) cmp.Resource {
+				return c.CmpClient.RouterNat
+			}),
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
 		ReadContext:   routerNatRuleReadContext,
 		CreateContext: routerNatRuleCreateContext,
 		UpdateContext: routerNatRuleUpdateContext,
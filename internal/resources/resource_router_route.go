@@ -5,6 +5,7 @@ package resources
 import (
 	"context"
 
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/cmp"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/validations"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
@@ -25,27 +26,23 @@ func RouterRoute() *schema.Resource {
 				Type:        schema.TypeString,
 				Required:    true,
 				Description: "Name of the route.",
-				ForceNew:    true,
 			},
 			"description": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Description: "Description for the route.",
-				ForceNew:    true,
 			},
 			"enabled": {
 				Type:        schema.TypeBool,
 				Default:     true,
 				Optional:    true,
 				Description: "If `true` then route will be active/enabled.",
-				ForceNew:    true,
 			},
 			"default_route": {
 				Type:        schema.TypeBool,
 				Default:     false,
 				Optional:    true,
 				Description: "If `true` then the route will considered as the default route.",
-				ForceNew:    true,
 			},
 			"network": {
 				Type:             schema.TypeString,
@@ -59,13 +56,11 @@ func RouterRoute() *schema.Resource {
 				Required:         true,
 				ValidateDiagFunc: validations.ValidateIPAddress,
 				Description:      "Next Hop/Destination IPv4 Address",
-				ForceNew:         true,
 			},
 			"mtu": {
 				Type:        schema.TypeInt,
 				Optional:    true,
 				Description: "Network MTU",
-				ForceNew:    true,
 			},
 			"priority": {
 				Type:             schema.TypeInt,
@@ -73,7 +68,6 @@ func RouterRoute() *schema.Resource {
 				Default:          100,
 				Description:      "Priority for the route",
 				ValidateDiagFunc: validations.IntAtLeast(1),
-				ForceNew:         true,
 			},
 			"is_deprecated": {
 				Type:        schema.TypeBool,
@@ -101,8 +95,19 @@ func RouterRoute() *schema.Resource {
 				Computed: true,
 			},
 		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importContext(func(c *client.Client) cmp.Resource {
+				return c.CmpClient.RouterRoute
+			}),
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
 		ReadContext:   routerRouteReadContext,
 		CreateContext: routerRouteCreateContext,
+		UpdateContext: routerRouteUpdateContext,
 		DeleteContext: routerRouteDeleteContext,
 		Description: `Router route resource facilitates creating,
 		updating and deleting NSX-T Network Router routes.`,
@@ -146,19 +151,19 @@ func routerRouteCreateContext(ctx context.Context, rd *schema.ResourceData, meta
 	return routerRouteReadContext(ctx, rd, meta)
 }
 
-// func routerRouteUpdateContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
-// 	c, err := client.GetClientFromMetaMap(meta)
-// 	if err != nil {
-// 		return diag.FromErr(err)
-// 	}
+func routerRouteUpdateContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-// 	data := utils.NewData(rd)
-// 	if err := c.CmpClient.RouterRoute.Update(ctx, data, meta); err != nil {
-// 		return diag.FromErr(err)
-// 	}
+	data := utils.NewData(rd)
+	if err := c.CmpClient.RouterRoute.Update(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
 
-// 	return routerRouteReadContext(ctx, rd, meta)
-// }
+	return routerRouteReadContext(ctx, rd, meta)
+}
 
 func routerRouteDeleteContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	c, err := client.GetClientFromMetaMap(meta)
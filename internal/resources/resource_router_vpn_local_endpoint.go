@@ -0,0 +1,133 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package resources
+
+import (
+	"context"
+
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/cmp"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/validations"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func RouterVpnLocalEndpoint() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"router_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Parent router ID, router_id can be obtained by using router datasource/resource.",
+			},
+			"vpn_service_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+				Description: "ID of the IPsec VPN service, vpn_service_id can be obtained by using " +
+					ResRouterVpnService + " resource",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the local endpoint",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the local endpoint",
+			},
+			"local_address": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validations.ValidateIPAddress,
+				Description:      "IPv4 address of the local endpoint, which is used by the peer sites to connect",
+			},
+			"local_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Local identifier of the endpoint. If not set, `local_address` is used as the identifier",
+			},
+			"provider_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "NSX-T path of the local endpoint",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importContext(func(c *client.Client) cmp.Resource {
+				return c.CmpClient.RouterVpnLocalEndpoint
+			}),
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+		ReadContext:   routerVpnLocalEndpointReadContext,
+		CreateContext: routerVpnLocalEndpointCreateContext,
+		UpdateContext: routerVpnLocalEndpointUpdateContext,
+		DeleteContext: routerVpnLocalEndpointDeleteContext,
+		Description: `Router VPN local endpoint resource facilitates creating, updating
+		and deleting local endpoints of IPsec VPN service of NSX-T Gateways.`,
+	}
+}
+
+func routerVpnLocalEndpointReadContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.RouterVpnLocalEndpoint.Read(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func routerVpnLocalEndpointCreateContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.RouterVpnLocalEndpoint.Create(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return routerVpnLocalEndpointReadContext(ctx, rd, meta)
+}
+
+func routerVpnLocalEndpointUpdateContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.RouterVpnLocalEndpoint.Update(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return routerVpnLocalEndpointReadContext(ctx, rd, meta)
+}
+
+func routerVpnLocalEndpointDeleteContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.RouterVpnLocalEndpoint.Delete(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
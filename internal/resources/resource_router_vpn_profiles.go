@@ -0,0 +1,429 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package resources
+
+import (
+	"context"
+
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/cmp"
+	diffvalidation "github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/diffValidation"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/schemas"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/validations"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func RouterVpnIkeProfile() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"router_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+				Description: "Parent router ID, router_id can be obtained by using router datasource/resource. " +
+					"Router should be a NSX Tier-0 or Tier-1 Gateway",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the IKE profile",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the IKE profile",
+			},
+			"ike_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "IKE_V2",
+				ValidateDiagFunc: validations.StringInSlice([]string{
+					"IKE_V1", "IKE_V2", "IKE_FLEX",
+				}, false),
+				Description: "IKE protocol version. Supported values are `IKE_V1`, `IKE_V2` and `IKE_FLEX`. " +
+					"With `IKE_FLEX`, IKE V2 is initiated and IKE V1 is accepted from the peer",
+			},
+			"encryption_algorithms": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateDiagFunc: validations.StringInSlice([]string{
+						"AES_128", "AES_256", "AES_GCM_128", "AES_GCM_192", "AES_GCM_256",
+					}, false),
+				},
+				Description: "Algorithms used for the encryption of the IKE negotiation. Supported values are " +
+					"`AES_128`, `AES_256`, `AES_GCM_128`, `AES_GCM_192` and `AES_GCM_256`",
+			},
+			"digest_algorithms": schemas.VpnDigestAlgorithmsSchema(),
+			"dh_groups":         schemas.VpnDhGroupsSchema(true),
+			"sa_life_time": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          86400,
+				ValidateDiagFunc: validations.IntBetween(21600, 31536000),
+				Description:      "Lifetime of the security association in seconds",
+			},
+			"provider_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "NSX-T path of the IKE profile",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importContext(func(c *client.Client) cmp.Resource {
+				return c.CmpClient.RouterVpnIkeProfile
+			}),
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+		ReadContext:   routerVpnIkeProfileReadContext,
+		CreateContext: routerVpnIkeProfileCreateContext,
+		UpdateContext: routerVpnIkeProfileUpdateContext,
+		DeleteContext: routerVpnIkeProfileDeleteContext,
+		CustomizeDiff: routerVpnIkeProfileCustomDiff,
+		Description: `Router VPN IKE profile resource facilitates creating, updating
+		and deleting IKE profiles, which are used for the phase 1 negotiation of IPsec VPN sessions.`,
+	}
+}
+
+func routerVpnIkeProfileReadContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.RouterVpnIkeProfile.Read(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func routerVpnIkeProfileCreateContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.RouterVpnIkeProfile.Create(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return routerVpnIkeProfileReadContext(ctx, rd, meta)
+}
+
+func routerVpnIkeProfileUpdateContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.RouterVpnIkeProfile.Update(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return routerVpnIkeProfileReadContext(ctx, rd, meta)
+}
+
+func routerVpnIkeProfileDeleteContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.RouterVpnIkeProfile.Delete(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func routerVpnIkeProfileCustomDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	return diffvalidation.NewRouterVpnValidate(diff).IkeProfileDiffValidate()
+}
+
+func RouterVpnIpsecProfile() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"router_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+				Description: "Parent router ID, router_id can be obtained by using router datasource/resource. " +
+					"Router should be a NSX Tier-0 or Tier-1 Gateway",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the IPsec profile",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the IPsec profile",
+			},
+			"encryption_algorithms": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateDiagFunc: validations.StringInSlice([]string{
+						"AES_128", "AES_256", "AES_GCM_128", "AES_GCM_192", "AES_GCM_256",
+						"NO_ENCRYPTION_AUTH_AES_GMAC_128", "NO_ENCRYPTION_AUTH_AES_GMAC_192",
+						"NO_ENCRYPTION_AUTH_AES_GMAC_256", "NO_ENCRYPTION",
+					}, false),
+				},
+				Description: "Algorithms used for the encryption of the tunnel traffic. Supported values are " +
+					"`AES_128`, `AES_256`, `AES_GCM_128`, `AES_GCM_192`, `AES_GCM_256`, " +
+					"`NO_ENCRYPTION_AUTH_AES_GMAC_128`, `NO_ENCRYPTION_AUTH_AES_GMAC_192`, " +
+					"`NO_ENCRYPTION_AUTH_AES_GMAC_256` and `NO_ENCRYPTION`",
+			},
+			"digest_algorithms": schemas.VpnDigestAlgorithmsSchema(),
+			"dh_groups":         schemas.VpnDhGroupsSchema(false),
+			"enable_perfect_forward_secrecy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "If `true` then new keys are generated with Diffie-Hellman groups on each rekey",
+			},
+			"sa_life_time": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          3600,
+				ValidateDiagFunc: validations.IntBetween(900, 31536000),
+				Description:      "Lifetime of the security association in seconds",
+			},
+			"df_policy": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "COPY",
+				ValidateDiagFunc: validations.StringInSlice([]string{"COPY", "CLEAR"}, false),
+				Description: "Policy of the DF (don't fragment) bit of the tunnel packets. Supported values are " +
+					"`COPY`, which copies the DF bit of the inner packet and `CLEAR`, which ignores it",
+			},
+			"provider_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "NSX-T path of the IPsec profile",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importContext(func(c *client.Client) cmp.Resource {
+				return c.CmpClient.RouterVpnIpsecProfile
+			}),
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+		ReadContext:   routerVpnIpsecProfileReadContext,
+		CreateContext: routerVpnIpsecProfileCreateContext,
+		UpdateContext: routerVpnIpsecProfileUpdateContext,
+		DeleteContext: routerVpnIpsecProfileDeleteContext,
+		Description: `Router VPN IPsec profile resource facilitates creating, updating
+		and deleting IPsec tunnel profiles, which are used for the phase 2 negotiation of IPsec VPN sessions.`,
+	}
+}
+
+func routerVpnIpsecProfileReadContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.RouterVpnIpsecProfile.Read(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func routerVpnIpsecProfileCreateContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.RouterVpnIpsecProfile.Create(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return routerVpnIpsecProfileReadContext(ctx, rd, meta)
+}
+
+func routerVpnIpsecProfileUpdateContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.RouterVpnIpsecProfile.Update(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return routerVpnIpsecProfileReadContext(ctx, rd, meta)
+}
+
+func routerVpnIpsecProfileDeleteContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.RouterVpnIpsecProfile.Delete(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func RouterVpnDpdProfile() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"router_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+				Description: "Parent router ID, router_id can be obtained by using router datasource/resource. " +
+					"Router should be a NSX Tier-0 or Tier-1 Gateway",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the DPD profile",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the DPD profile",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "If `true` then dead peer detection is enabled",
+			},
+			"dpd_probe_mode": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "PERIODIC",
+				ValidateDiagFunc: validations.StringInSlice([]string{"PERIODIC", "ON_DEMAND"}, false),
+				Description: "Probe mode of the dead peer detection. Supported values are `PERIODIC`, which sends " +
+					"the probes periodically and `ON_DEMAND`, which sends the probes only if there is no traffic from the peer",
+			},
+			"dpd_probe_interval": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  60,
+				Description: "Interval between the probes in seconds. Should be between 3 and 360 for `PERIODIC` " +
+					"and between 1 and 10 for `ON_DEMAND` probe mode",
+			},
+			"retry_count": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          10,
+				ValidateDiagFunc: validations.IntBetween(1, 100),
+				Description:      "Number of retries before the peer is considered as dead",
+			},
+			"provider_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "NSX-T path of the DPD profile",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importContext(func(c *client.Client) cmp.Resource {
+				return c.CmpClient.RouterVpnDpdProfile
+			}),
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+		ReadContext:   routerVpnDpdProfileReadContext,
+		CreateContext: routerVpnDpdProfileCreateContext,
+		UpdateContext: routerVpnDpdProfileUpdateContext,
+		DeleteContext: routerVpnDpdProfileDeleteContext,
+		CustomizeDiff: routerVpnDpdProfileCustomDiff,
+		Description: `Router VPN DPD profile resource facilitates creating, updating
+		and deleting dead peer detection profiles of IPsec VPN sessions.`,
+	}
+}
+
+func routerVpnDpdProfileReadContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.RouterVpnDpdProfile.Read(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func routerVpnDpdProfileCreateContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.RouterVpnDpdProfile.Create(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return routerVpnDpdProfileReadContext(ctx, rd, meta)
+}
+
+func routerVpnDpdProfileUpdateContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.RouterVpnDpdProfile.Update(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return routerVpnDpdProfileReadContext(ctx, rd, meta)
+}
+
+func routerVpnDpdProfileDeleteContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.RouterVpnDpdProfile.Delete(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func routerVpnDpdProfileCustomDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	return diffvalidation.NewRouterVpnValidate(diff).DpdProfileDiffValidate()
+}
@@ -0,0 +1,137 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package resources
+
+import (
+	"context"
+
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/cmp"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/validations"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func RouterVpnService() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"router_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+				Description: "Parent router ID, router_id can be obtained by using router datasource/resource. " +
+					"Router should be a NSX Tier-0 or Tier-1 Gateway",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the IPsec VPN service",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the IPsec VPN service",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "If `true` then the IPsec VPN service is enabled",
+			},
+			"ike_log_level": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "INFO",
+				ValidateDiagFunc: validations.StringInSlice([]string{
+					"DEBUG", "INFO", "WARN", "ERROR", "EMERGENCY",
+				}, false),
+				Description: "Log level of the IKE negotiation. Supported values are `DEBUG`, `INFO`, " +
+					"`WARN`, `ERROR` and `EMERGENCY`",
+			},
+			"ha_sync": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "If `true` then the state of the sessions is synchronized to the standby edge node",
+			},
+			"provider_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "NSX-T path of the IPsec VPN service",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importContext(func(c *client.Client) cmp.Resource {
+				return c.CmpClient.RouterVpnService
+			}),
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+		ReadContext:   routerVpnServiceReadContext,
+		CreateContext: routerVpnServiceCreateContext,
+		UpdateContext: routerVpnServiceUpdateContext,
+		DeleteContext: routerVpnServiceDeleteContext,
+		Description: `Router VPN service resource facilitates creating, updating
+		and deleting IPsec VPN service of NSX-T Tier-0 and Tier-1 Gateways.`,
+	}
+}
+
+func routerVpnServiceReadContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.RouterVpnService.Read(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func routerVpnServiceCreateContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.RouterVpnService.Create(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return routerVpnServiceReadContext(ctx, rd, meta)
+}
+
+func routerVpnServiceUpdateContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.RouterVpnService.Update(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return routerVpnServiceReadContext(ctx, rd, meta)
+}
+
+func routerVpnServiceDeleteContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.RouterVpnService.Delete(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
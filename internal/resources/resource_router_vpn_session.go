@@ -0,0 +1,204 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package resources
+
+import (
+	"context"
+
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/cmp"
+	diffvalidation "github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/diffValidation"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/schemas"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/validations"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func RouterVpnSession() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"router_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Parent router ID, router_id can be obtained by using router datasource/resource.",
+			},
+			"vpn_service_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+				Description: "ID of the IPsec VPN service, vpn_service_id can be obtained by using " +
+					ResRouterVpnService + " resource",
+			},
+			"local_endpoint_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				Description: "ID of the local endpoint, local_endpoint_id can be obtained by using " +
+					ResRouterVpnLocalEndpoint + " resource",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the IPsec VPN session",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the IPsec VPN session",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "If `true` then the IPsec VPN session is enabled",
+			},
+			"type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validations.StringInSlice([]string{"POLICY_BASED", "ROUTE_BASED"}, false),
+				Description:      "Type of the session. Supported values are `POLICY_BASED` and `ROUTE_BASED`",
+			},
+			"peer_address": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validations.ValidateIPAddress,
+				Description:      "Public IPv4 address of the peer site",
+			},
+			"peer_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Identifier of the peer site. If not set, `peer_address` is used as the identifier",
+			},
+			"psk": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "Pre-shared key used for the authentication with the peer site",
+			},
+			"ike_profile_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				Description: "ID of the IKE profile, ike_profile_id can be obtained by using " +
+					ResRouterVpnIkeProfile + " resource. If not set, default IKE profile of NSX-T is used",
+			},
+			"ipsec_profile_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				Description: "ID of the IPsec profile, ipsec_profile_id can be obtained by using " +
+					ResRouterVpnIpsecProfile + " resource. If not set, default IPsec profile of NSX-T is used",
+			},
+			"dpd_profile_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				Description: "ID of the DPD profile, dpd_profile_id can be obtained by using " +
+					ResRouterVpnDpdProfile + " resource. If not set, default DPD profile of NSX-T is used",
+			},
+			"connection_initiation_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "INITIATOR",
+				ValidateDiagFunc: validations.StringInSlice([]string{
+					"INITIATOR", "RESPOND_ONLY", "ON_DEMAND",
+				}, false),
+				Description: "Connection initiation mode of the session. Supported values are `INITIATOR`, " +
+					"`RESPOND_ONLY` and `ON_DEMAND`",
+			},
+			"tunnel_interface_cidr": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validations.ValidateCidr,
+				Description: "IP address of the virtual tunnel interface along with the prefix length, " +
+					"for example `169.254.10.1/30`. Required for `ROUTE_BASED` session",
+			},
+			"rule": schemas.VpnSessionRuleSchema(),
+			"provider_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "NSX-T path of the IPsec VPN session",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importContext(func(c *client.Client) cmp.Resource {
+				return c.CmpClient.RouterVpnSession
+			}),
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+		ReadContext:   routerVpnSessionReadContext,
+		CreateContext: routerVpnSessionCreateContext,
+		UpdateContext: routerVpnSessionUpdateContext,
+		DeleteContext: routerVpnSessionDeleteContext,
+		CustomizeDiff: routerVpnSessionCustomDiff,
+		Description: `Router VPN session resource facilitates creating, updating
+		and deleting policy based and route based IPsec VPN sessions of NSX-T Gateways.`,
+	}
+}
+
+func routerVpnSessionReadContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.RouterVpnSession.Read(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func routerVpnSessionCreateContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.RouterVpnSession.Create(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return routerVpnSessionReadContext(ctx, rd, meta)
+}
+
+func routerVpnSessionUpdateContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.RouterVpnSession.Update(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return routerVpnSessionReadContext(ctx, rd, meta)
+}
+
+func routerVpnSessionDeleteContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.RouterVpnSession.Delete(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func routerVpnSessionCustomDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	return diffvalidation.NewRouterVpnValidate(diff).SessionDiffValidate()
+}
@@ -0,0 +1,163 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package resources
+
+import (
+	"context"
+
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/cmp"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/validations"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func SecurityGroup() *schema.Resource {
+	membership := []string{"tag", "instance_ids", "segment_ids", "ip_addresses"}
+
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"network_server_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "ID of the NSX-T network server which owns the security group",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the security group",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the security group",
+			},
+			"tag": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				AtLeastOneOf: membership,
+				Description: "VMs with the tag are the members of the security group. Tags of " +
+					ResInstance + " are available on the VM with the name of the tag as scope and " +
+					"the value of the tag as tag",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"scope": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Scope of the tag. Any scope is matched if not set",
+						},
+						"tag": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Value of the tag",
+						},
+					},
+				},
+			},
+			"instance_ids": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				AtLeastOneOf: membership,
+				Elem:         &schema.Schema{Type: schema.TypeInt},
+				Description:  "IDs of the instances, VMs of the instances are the members of the security group",
+			},
+			"segment_ids": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				AtLeastOneOf: membership,
+				Elem:         &schema.Schema{Type: schema.TypeInt},
+				Description: "IDs of the NSX-T segments, segment_ids can be obtained by using network " +
+					"datasource/resource. VMs connected to the segments are the members of the security group",
+			},
+			"ip_addresses": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				AtLeastOneOf: membership,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validations.ValidateIPorCidr,
+				},
+				Description: "IP addresses or subnets in CIDR format, which are the members of the security group",
+			},
+			"external_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "NSX-T path of the security group, external_id can be used as sources " +
+					"and destinations of " + ResDistributedFirewallPolicy + " rules",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importContext(func(c *client.Client) cmp.Resource {
+				return c.CmpClient.SecurityGroup
+			}),
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+		ReadContext:   securityGroupReadContext,
+		CreateContext: securityGroupCreateContext,
+		UpdateContext: securityGroupUpdateContext,
+		DeleteContext: securityGroupDeleteContext,
+		Description: `Security group resource facilitates creating, updating and deleting
+		NSX-T groups. Members of the group are the union of all the membership criteria.`,
+	}
+}
+
+func securityGroupReadContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.SecurityGroup.Read(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func securityGroupCreateContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.SecurityGroup.Create(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return securityGroupReadContext(ctx, rd, meta)
+}
+
+func securityGroupUpdateContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.SecurityGroup.Update(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return securityGroupReadContext(ctx, rd, meta)
+}
+
+func securityGroupDeleteContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.SecurityGroup.Delete(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package schemas
+
+import (
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/validations"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DfwRuleSchema returns the schema of the ordered rules of distributed firewall policy
+func DfwRuleSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MinItems: 1,
+		Description: "Rules of the policy. Rules are evaluated in the order as they appear in the " +
+			"configuration and the first matching rule is applied",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Name of the rule",
+				},
+				"description": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Description of the rule",
+				},
+				"enabled": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+					Description: "If `true` then the rule will be active/enabled",
+				},
+				"action": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateDiagFunc: validations.StringInSlice([]string{
+						"ALLOW", "DROP", "REJECT",
+					}, false),
+					Description: "Action on the traffic matching the rule. Supported values are `ALLOW`, " +
+						"`DROP` and `REJECT`",
+				},
+				"direction": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  "IN_OUT",
+					ValidateDiagFunc: validations.StringInSlice([]string{
+						"IN", "OUT", "IN_OUT",
+					}, false),
+					Description: "Direction of the traffic. Supported values are `IN`, `OUT` and `IN_OUT`",
+				},
+				"sources": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+					Description: "Source groups of the traffic, external_id of hpegl_vmaas_security_group " +
+						"can be used here. Rule matches any source if not set",
+				},
+				"destinations": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+					Description: "Destination groups of the traffic, external_id of hpegl_vmaas_security_group " +
+						"can be used here. Rule matches any destination if not set",
+				},
+				"services": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Services of the traffic. Rule matches any service if not set",
+				},
+				"applied_to": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+					Description: "Groups where the rule is enforced. Rule is enforced on all the workloads " +
+						"if not set",
+				},
+				"logging": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Enable/Disable Logging",
+				},
+			},
+		},
+	}
+}
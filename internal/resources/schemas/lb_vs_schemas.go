@@ -1,11 +1,13 @@
 package schemas
 
 import (
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/validations"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 const (
 	DSLBProfile = "hpegl_vmaas_load_balancer_profile"
+	DSLBPool    = "hpegl_vmaas_load_balancer_pool"
 )
 
 func TCPAppProfileSchema() *schema.Schema {
@@ -112,3 +114,176 @@ func SourceipPersProfileSchema() *schema.Schema {
 		},
 	}
 }
+
+func VirtualServerRuleSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Description: "HTTP rules of the virtual server. Rules are evaluated in the order as specified " +
+			"and supported only for `http` type virtual servers",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"phase": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateDiagFunc: validations.StringInSlice([]string{
+						"HTTP_REQUEST_REWRITE",
+						"HTTP_FORWARDING",
+						"HTTP_RESPONSE_REWRITE",
+						"HTTP_ACCESS",
+					}, false),
+					Description: "Load balancer processing phase in which the rule is executed. Supported values are " +
+						"`HTTP_REQUEST_REWRITE`, `HTTP_FORWARDING`, `HTTP_RESPONSE_REWRITE` and `HTTP_ACCESS`",
+				},
+				"match_strategy": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					Default:          "ALL",
+					ValidateDiagFunc: validations.StringInSlice([]string{"ALL", "ANY"}, false),
+					Description: "Strategy to evaluate the conditions. If `ALL` then the actions are executed only if " +
+						"all the conditions are matched, if `ANY` then actions are executed if any of the conditions matched",
+				},
+				"condition": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "Match conditions of the rule. If not set, the actions are always executed",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"type": {
+								Type:     schema.TypeString,
+								Required: true,
+								ValidateDiagFunc: validations.StringInSlice([]string{
+									"LBHttpRequestUriCondition",
+									"LBHttpRequestHeaderCondition",
+									"LBHttpRequestMethodCondition",
+									"LBHttpResponseHeaderCondition",
+									"LBIpHeaderCondition",
+								}, false),
+								Description: "Type of the condition. Supported values are `LBHttpRequestUriCondition`, " +
+									"`LBHttpRequestHeaderCondition`, `LBHttpRequestMethodCondition`, " +
+									"`LBHttpResponseHeaderCondition` and `LBIpHeaderCondition`",
+							},
+							"match_type": {
+								Type:     schema.TypeString,
+								Optional: true,
+								Default:  "REGEX",
+								ValidateDiagFunc: validations.StringInSlice([]string{
+									"REGEX", "STARTS_WITH", "ENDS_WITH", "EQUALS", "CONTAINS",
+								}, false),
+								Description: "Match type of the uri or header value. Supported values are `REGEX`, " +
+									"`STARTS_WITH`, `ENDS_WITH`, `EQUALS` and `CONTAINS`",
+							},
+							"uri": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "URI to be matched, applicable for `LBHttpRequestUriCondition`",
+							},
+							"header_name": {
+								Type:     schema.TypeString,
+								Optional: true,
+								Description: "Name of the header to be matched, applicable for `LBHttpRequestHeaderCondition` " +
+									"and `LBHttpResponseHeaderCondition`",
+							},
+							"header_value": {
+								Type:     schema.TypeString,
+								Optional: true,
+								Description: "Value of the header to be matched, applicable for `LBHttpRequestHeaderCondition` " +
+									"and `LBHttpResponseHeaderCondition`",
+							},
+							"method": {
+								Type:     schema.TypeString,
+								Optional: true,
+								ValidateDiagFunc: validations.StringInSlice([]string{
+									"GET", "OPTIONS", "POST", "HEAD", "PUT",
+								}, false),
+								Description: "HTTP method to be matched, applicable for `LBHttpRequestMethodCondition`",
+							},
+							"source_address": {
+								Type:             schema.TypeString,
+								Optional:         true,
+								ValidateDiagFunc: validations.ValidateIPorCidr,
+								Description:      "Source IP address or CIDR to be matched, applicable for `LBIpHeaderCondition`",
+							},
+							"case_sensitive": {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Default:     true,
+								Description: "If `true` then the value is matched case sensitive",
+							},
+							"inverse": {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Default:     false,
+								Description: "If `true` then the result of the condition is inverted",
+							},
+						},
+					},
+				},
+				"action": {
+					Type:        schema.TypeList,
+					Required:    true,
+					Description: "Actions to be executed when the conditions are matched",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"type": {
+								Type:     schema.TypeString,
+								Required: true,
+								ValidateDiagFunc: validations.StringInSlice([]string{
+									"LBSelectPoolAction",
+									"LBHttpRedirectAction",
+									"LBHttpRejectAction",
+									"LBHttpRequestUriRewriteAction",
+									"LBHttpRequestHeaderRewriteAction",
+									"LBHttpResponseHeaderRewriteAction",
+									"LBHttpRequestHeaderDeleteAction",
+									"LBHttpResponseHeaderDeleteAction",
+								}, false),
+								Description: "Type of the action. Supported values are `LBSelectPoolAction`, " +
+									"`LBHttpRedirectAction`, `LBHttpRejectAction`, `LBHttpRequestUriRewriteAction`, " +
+									"`LBHttpRequestHeaderRewriteAction`, `LBHttpResponseHeaderRewriteAction`, " +
+									"`LBHttpRequestHeaderDeleteAction` and `LBHttpResponseHeaderDeleteAction`",
+							},
+							"pool": {
+								Type:     schema.TypeInt,
+								Optional: true,
+								Description: "Pool Id, Get the `id` from " + DSLBPool + " datasource to obtain the Pool Id. " +
+									"Applicable for `LBSelectPoolAction`",
+							},
+							"redirect_url": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "URL to redirect the request, applicable for `LBHttpRedirectAction`",
+							},
+							"redirect_status": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "HTTP status code of the redirect, applicable for `LBHttpRedirectAction`",
+							},
+							"reply_status": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "HTTP status code of the reject response, applicable for `LBHttpRejectAction`",
+							},
+							"uri": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "Rewritten URI, applicable for `LBHttpRequestUriRewriteAction`",
+							},
+							"header_name": {
+								Type:     schema.TypeString,
+								Optional: true,
+								Description: "Name of the header to be rewritten or deleted, applicable for header " +
+									"rewrite and delete actions",
+							},
+							"header_value": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "Value of the header, applicable for header rewrite actions",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -1,6 +1,7 @@
 package schemas
 
 import (
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/validations"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -50,14 +51,17 @@ func DhcpNetworkSchema() *schema.Schema {
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"dhcp_type": {
-					Type:        schema.TypeString,
-					Required:    true,
-					Description: `DHCP Server type. Supported Values is "dhcpLocal"`,
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateDiagFunc: validations.StringInSlice([]string{"dhcpLocal", "dhcpRelay"}, false),
+					Description: `DHCP Server type. Supported Values are "dhcpLocal" and "dhcpRelay". ` +
+						`DHCP requests are forwarded to the external DHCP servers for "dhcpRelay"`,
 				},
 				"dhcp_server": {
-					Type:        schema.TypeString,
-					Required:    true,
-					Description: "DHCP server ID. Use " + DSDhcpServer + " Data source's `provider_id` here.",
+					Type:     schema.TypeString,
+					Required: true,
+					Description: "DHCP server ID. Use " + DSDhcpServer + " Data source's `provider_id` here. " +
+						"For `dhcpRelay` use `provider_id` of hpegl_vmaas_dhcp_relay resource.",
 				},
 				"dhcp_server_address": {
 					Type:     schema.TypeString,
@@ -68,13 +72,13 @@ func DhcpNetworkSchema() *schema.Schema {
 				},
 				"dhcp_range": {
 					Type:        schema.TypeString,
-					Required:    true,
-					Description: "DHCP server IP Address range",
+					Optional:    true,
+					Description: "DHCP server IP Address range. Required for `dhcpLocal`",
 				},
 				"dhcp_lease_time": {
 					Type:        schema.TypeString,
-					Required:    true,
-					Description: "DHCP Server default lease time",
+					Optional:    true,
+					Description: "DHCP Server default lease time. Required for `dhcpLocal`",
 				},
 			},
 		},
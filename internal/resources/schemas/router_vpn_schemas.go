@@ -0,0 +1,86 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package schemas
+
+import (
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/validations"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// VpnDigestAlgorithmsSchema returns the schema of the digest algorithms, which are
+// common for IKE and IPsec profiles
+func VpnDigestAlgorithmsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+			ValidateDiagFunc: validations.StringInSlice([]string{
+				"SHA1", "SHA2_256", "SHA2_384", "SHA2_512",
+			}, false),
+		},
+		Description: "Algorithms used for the message digest. Supported values are `SHA1`, `SHA2_256`, " +
+			"`SHA2_384` and `SHA2_512`. Should not be set if only `AES_GCM` encryption algorithms are used",
+	}
+}
+
+// VpnDhGroupsSchema returns the schema of the Diffie-Hellman groups, which are
+// common for IKE and IPsec profiles
+func VpnDhGroupsSchema(required bool) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: required,
+		Optional: !required,
+		MinItems: 1,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+			ValidateDiagFunc: validations.StringInSlice([]string{
+				"GROUP2", "GROUP5", "GROUP14", "GROUP15", "GROUP16", "GROUP19", "GROUP20", "GROUP21",
+			}, false),
+		},
+		Description: "Diffie-Hellman groups used for the key exchange. Supported values are `GROUP2`, `GROUP5`, " +
+			"`GROUP14`, `GROUP15`, `GROUP16`, `GROUP19`, `GROUP20` and `GROUP21`",
+	}
+}
+
+// VpnSessionRuleSchema returns the schema of the rules of policy based IPsec VPN session
+func VpnSessionRuleSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Description: "Rules of the policy based session. Traffic between the sources and the destinations " +
+			"is protected by the session. Required for `POLICY_BASED` session",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"sources": {
+					Type:     schema.TypeList,
+					Required: true,
+					MinItems: 1,
+					Elem: &schema.Schema{
+						Type:             schema.TypeString,
+						ValidateDiagFunc: validations.ValidateCidr,
+					},
+					Description: "Local subnets in CIDR format",
+				},
+				"destinations": {
+					Type:     schema.TypeList,
+					Required: true,
+					MinItems: 1,
+					Elem: &schema.Schema{
+						Type:             schema.TypeString,
+						ValidateDiagFunc: validations.ValidateCidr,
+					},
+					Description: "Peer subnets in CIDR format",
+				},
+				"action": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					Default:          "PROTECT",
+					ValidateDiagFunc: validations.StringInSlice([]string{"PROTECT", "BYPASS"}, false),
+					Description: "Action of the rule. Supported values are `PROTECT` and `BYPASS`. " +
+						"Traffic is not encrypted for `BYPASS`",
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,37 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package validations
+
+import (
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// ValidateCertificate validates PEM encoded certificate
+func ValidateCertificate(i interface{}, p cty.Path) diag.Diagnostics {
+	v, ok := i.(string)
+	if !ok || v == "" {
+		return nil
+	}
+
+	if _, err := utils.ParseCertificate(v); err != nil {
+		return diag.Errorf("invalid certificate, %v", err)
+	}
+
+	return nil
+}
+
+// ValidatePrivateKey validates PEM encoded private key
+func ValidatePrivateKey(i interface{}, p cty.Path) diag.Diagnostics {
+	v, ok := i.(string)
+	if !ok || v == "" {
+		return nil
+	}
+
+	if !utils.IsPrivateKey(v) {
+		return diag.Errorf("invalid private key, expected PEM encoded private key")
+	}
+
+	return nil
+}
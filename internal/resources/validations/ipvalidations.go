@@ -59,3 +59,14 @@ func ValidateIPorCidr(i interface{}, p cty.Path) diag.Diagnostics {
 
 	return errsTodiags(errors)
 }
+
+// ValidateMacAddress validates MAC address
+func ValidateMacAddress(i interface{}, p cty.Path) diag.Diagnostics {
+	if i == nil {
+		return nil
+	}
+
+	_, errs := validation.IsMACAddress(i, "")
+
+	return errsTodiags(errs)
+}
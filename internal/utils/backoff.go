@@ -0,0 +1,61 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package utils
+
+import (
+	"math"
+	"math/rand"
+	"time"
+)
+
+// BackoffFunc returns the delay before the next retry. attempt starts from 1 for the
+// first retry and prev is the delay returned for the previous attempt
+type BackoffFunc func(attempt int, prev time.Duration) time.Duration
+
+// ConstantBackoff waits for the same delay between each retry
+func ConstantBackoff(delay time.Duration) BackoffFunc {
+	return func(attempt int, prev time.Duration) time.Duration {
+		return delay
+	}
+}
+
+// ExponentialBackoff doubles the delay on each retry starting from base,
+// delay will not exceed maxDelay. If maxDelay is 0, delay is not capped.
+func ExponentialBackoff(base, maxDelay time.Duration) BackoffFunc {
+	return func(attempt int, prev time.Duration) time.Duration {
+		delay := base
+		for i := 1; i < attempt && delay < math.MaxInt64/2; i++ {
+			if maxDelay > 0 && delay >= maxDelay {
+				break
+			}
+			delay *= 2
+		}
+
+		return capDelay(delay, maxDelay)
+	}
+}
+
+// DecorrelatedJitterBackoff picks a random delay in between base and three times of
+// the previous delay, delay will not exceed maxDelay. This avoids multiple resources
+// polling the API at the same time.
+func DecorrelatedJitterBackoff(base, maxDelay time.Duration) BackoffFunc {
+	return func(attempt int, prev time.Duration) time.Duration {
+		if prev < base {
+			prev = base
+		}
+		upper := prev * 3
+		if upper <= base {
+			return capDelay(base, maxDelay)
+		}
+
+		return capDelay(base+time.Duration(rand.Int63n(int64(upper-base))), maxDelay)
+	}
+}
+
+func capDelay(delay, maxDelay time.Duration) time.Duration {
+	if maxDelay > 0 && delay > maxDelay {
+		return maxDelay
+	}
+
+	return delay
+}
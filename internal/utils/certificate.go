@@ -0,0 +1,27 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package utils
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"strings"
+)
+
+// ParseCertificate parses the first certificate from the PEM encoded data
+func ParseCertificate(data string) (*x509.Certificate, error) {
+	block, _ := pem.Decode([]byte(strings.TrimSpace(data)))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, fmt.Errorf("failed to decode PEM certificate")
+	}
+
+	return x509.ParseCertificate(block.Bytes)
+}
+
+// IsPrivateKey returns true if data is a PEM encoded private key
+func IsPrivateKey(data string) bool {
+	block, _ := pem.Decode([]byte(strings.TrimSpace(data)))
+
+	return block != nil && strings.HasSuffix(block.Type, "PRIVATE KEY")
+}
@@ -35,4 +35,8 @@ const (
 	Deleted         = "deleted"
 	Failed          = "failed"
 	PortGroupPrefix = "dvportgroup-"
+	// network interface ip mode constants
+	IPModeDhcp   = "dhcp"
+	IPModeStatic = "static"
+	IPModePool   = "pool"
 )
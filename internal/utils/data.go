@@ -7,6 +7,7 @@ import (
 	"errors"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -286,6 +287,12 @@ func (d *Data) GetBool(key string) bool {
 	return false
 }
 
+// Timeout returns the timeout configured for an operation. Key should be one of
+// schema.TimeoutCreate, schema.TimeoutUpdate, schema.TimeoutDelete etc.
+func (d *Data) Timeout(key string) time.Duration {
+	return d.d.Timeout(key)
+}
+
 func (d *Data) SetString(key string, value string) {
 	if err := d.set(key, value); err != nil {
 		d.err(key, ErrSet+" : "+err.Error())
@@ -0,0 +1,60 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package utils
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+)
+
+// IPRange is an inclusive range of IPv4 addresses
+type IPRange struct {
+	StartAddress string
+	EndAddress   string
+}
+
+// NextFreeIP returns the first IPv4 address in the ranges which is not in use.
+// Ranges are searched in the given order
+func NextFreeIP(ranges []IPRange, used []string) (string, error) {
+	usedSet := make(map[uint32]struct{}, len(used))
+	for _, u := range used {
+		if ip, err := ipToUint(u); err == nil {
+			usedSet[ip] = struct{}{}
+		}
+	}
+
+	for _, r := range ranges {
+		start, err := ipToUint(r.StartAddress)
+		if err != nil {
+			return "", err
+		}
+		end, err := ipToUint(r.EndAddress)
+		if err != nil {
+			return "", err
+		}
+		for ip := start; ip <= end && ip >= start; ip++ {
+			if _, ok := usedSet[ip]; !ok {
+				return uintToIP(ip), nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("no free IP address is available in the pool")
+}
+
+func ipToUint(address string) (uint32, error) {
+	ip := net.ParseIP(address).To4()
+	if ip == nil {
+		return 0, fmt.Errorf("%s is not a valid IPv4 address", address)
+	}
+
+	return binary.BigEndian.Uint32(ip), nil
+}
+
+func uintToIP(ip uint32) string {
+	b := make(net.IP, net.IPv4len)
+	binary.BigEndian.PutUint32(b, ip)
+
+	return b.String()
+}
@@ -0,0 +1,66 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package utils
+
+import "testing"
+
+func TestNextFreeIP(t *testing.T) {
+	tests := []struct {
+		name    string
+		ranges  []IPRange
+		used    []string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:   "Test case 1: nothing is used",
+			ranges: []IPRange{{StartAddress: "10.0.0.10", EndAddress: "10.0.0.20"}},
+			want:   "10.0.0.10",
+		},
+		{
+			name:   "Test case 2: start of the range is used",
+			ranges: []IPRange{{StartAddress: "10.0.0.10", EndAddress: "10.0.0.20"}},
+			used:   []string{"10.0.0.10", "10.0.0.11", "10.0.0.13"},
+			want:   "10.0.0.12",
+		},
+		{
+			name: "Test case 3: first range is full",
+			ranges: []IPRange{
+				{StartAddress: "10.0.0.254", EndAddress: "10.0.0.255"},
+				{StartAddress: "10.0.1.0", EndAddress: "10.0.1.10"},
+			},
+			used: []string{"10.0.0.254", "10.0.0.255"},
+			want: "10.0.1.0",
+		},
+		{
+			name:    "Test case 4: all the ranges are full",
+			ranges:  []IPRange{{StartAddress: "10.0.0.10", EndAddress: "10.0.0.11"}},
+			used:    []string{"10.0.0.11", "10.0.0.10"},
+			wantErr: true,
+		},
+		{
+			name:    "Test case 5: invalid range",
+			ranges:  []IPRange{{StartAddress: "10.0.0", EndAddress: "10.0.0.11"}},
+			wantErr: true,
+		},
+		{
+			name:   "Test case 6: invalid used address is ignored",
+			ranges: []IPRange{{StartAddress: "255.255.255.254", EndAddress: "255.255.255.255"}},
+			used:   []string{"", "255.255.255.254"},
+			want:   "255.255.255.255",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NextFreeIP(tt.ranges, tt.used)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NextFreeIP() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+			if got != tt.want {
+				t.Errorf("NextFreeIP() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
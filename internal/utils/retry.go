@@ -27,22 +27,19 @@ type continueStruct struct {
 	respErr error
 }
 
-// retryChan used as an arguments for groutine function retryRoutineFunc
-type retryChan struct {
-	errChan      chan error
-	respChan     chan interface{}
-	continueChan chan continueStruct
-}
-
-// CustomRetry allows developers to configure the timeout, retry count and delay
+// CustomRetry allows developers to configure the timeout, retry count and delay.
+// Backoff overrides RetryDelay, if specified.
 type CustomRetry struct {
 	RetryCount   int
 	RetryDelay   time.Duration
 	InitialDelay time.Duration
+	Backoff      BackoffFunc
 	Cond         CondFunc
 	Timeout      time.Duration
 	apiChan      chan continueStruct
 	tclient      scmTokenInterface
+	attempt      int
+	delay        time.Duration
 }
 
 // setScmClientToken calls auth.SetScmClientToken
@@ -62,8 +59,8 @@ func defaultCond(resp interface{}, err error) (bool, error) {
 	return err == nil, nil
 }
 
-// retry supports both retry with count and timeout as well and returns result as
-// interface{}. This result can converted to proper struct/model afterwards
+// retry runs the retry as a routine and sends the result to apiChan. apiChan is
+// buffered, so the routine exits even if the result is never read.
 func retry(
 	ctx context.Context,
 	meta interface{},
@@ -71,77 +68,97 @@ func retry(
 	cRetry *CustomRetry,
 	tClient scmTokenInterface,
 ) {
-	rChan := retryChan{
-		errChan:      make(chan error),
-		respChan:     make(chan interface{}),
-		continueChan: make(chan continueStruct),
-	}
-
-	timeoutTimer := time.NewTimer(cRetry.Timeout)
-
-	// wait initial delay and trigger retry first and then wait for channels.
-	go func() {
-		time.Sleep(cRetry.InitialDelay)
-		retryRoutineFunc(ctx, meta, rChan, tClient, cRetry, fn)
-	}()
 	go func(apiChan chan continueStruct) {
-		for i := 0; ; i++ {
-			select {
-			case <-ctx.Done():
-				apiChan <- continueStruct{
-					respErr: fmt.Errorf("context timed out"),
-				}
-
-				return
-			case <-timeoutTimer.C:
-				apiChan <- continueStruct{
-					respErr: fmt.Errorf("retry timed out"),
-				}
-			case continueChan := <-rChan.continueChan:
-				// check exit condition before invoking next retry
-				if i == cRetry.RetryCount-1 {
-					apiChan <- continueStruct{
-						respErr: fmt.Errorf(
-							"maximum retry limit reached, with Error: %s, Response: %#v",
-							continueChan.respErr.Error(),
-							continueChan.resp,
-						),
-					}
-
-					return
-				}
-				// call retry function
-				go retryRoutineFunc(ctx, meta, rChan, tClient, cRetry, fn)
-
-			// check for error while retrying
-			case err := <-rChan.errChan:
-				apiChan <- continueStruct{
-					respErr: err,
-				}
-
-				return
-
-			// if response received, stop retrying and return backs the result
-			case resp := <-rChan.respChan:
-				apiChan <- continueStruct{
-					resp: resp,
-				}
-
-				return
-			}
+		resp, err := cRetry.run(ctx, meta, fn, tClient)
+		apiChan <- continueStruct{
+			resp:    resp,
+			respErr: err,
 		}
 	}(cRetry.apiChan)
 }
 
+// run supports both retry with count and timeout as well and returns result as
+// interface{}. This result can converted to proper struct/model afterwards.
+// Timeout is applied on the context, so that cancellation, timeout and retry count
+// stops the retry without leaving any routines behind. fn should honor the context
+// to get cancelled in between an execution.
+func (c *CustomRetry) run(
+	ctx context.Context,
+	meta interface{},
+	fn RetryFunc,
+	tClient scmTokenInterface,
+) (interface{}, error) {
+	retryCtx, cancel := context.WithTimeout(ctx, c.Timeout)
+	defer cancel()
+
+	// wait initial delay before triggering the first retry
+	if !sleepWithContext(retryCtx, c.InitialDelay) {
+		return nil, retryTimeoutErr(ctx)
+	}
+	for i := 0; ; i++ {
+		fnCtx := retryCtx
+		tClient.setScmClientToken(&fnCtx, meta)
+		resp, respErr := fn(fnCtx)
+		if retryCtx.Err() != nil {
+			return nil, retryTimeoutErr(ctx)
+		}
+
+		ok, err := c.Cond(resp, respErr)
+		if err != nil {
+			return nil, err
+		}
+		// if response received, stop retrying and return backs the result
+		if ok {
+			return resp, nil
+		}
+		// check exit condition before invoking next retry
+		if i == c.RetryCount-1 {
+			return nil, fmt.Errorf(
+				"maximum retry limit reached, with Error: %v, Response: %#v",
+				respErr,
+				resp,
+			)
+		}
+		log.Printf("[WARN] on API execution. Error:%#v, Response: %#v", respErr, resp)
+		if !sleepWithContext(retryCtx, c.nextDelay(respErr)) {
+			return nil, retryTimeoutErr(ctx)
+		}
+	}
+}
+
+// sleepWithContext waits for the delay, returns false if the context is done
+// before that
+func sleepWithContext(ctx context.Context, delay time.Duration) bool {
+	if delay <= 0 {
+		return ctx.Err() == nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// retryTimeoutErr returns error based on whether the parent context is done or
+// the retry itself timed out
+func retryTimeoutErr(ctx context.Context) error {
+	if ctx.Err() != nil {
+		return fmt.Errorf("context timed out")
+	}
+
+	return fmt.Errorf("retry timed out")
+}
+
 // Retry with default count and timeout
 func Retry(ctx context.Context, meta interface{}, fn RetryFunc) (interface{}, error) {
 	c := &CustomRetry{}
 	c.setDefaultValues()
 
-	retry(ctx, meta, fn, c, c.tclient)
-	apiChan := <-c.apiChan
-
-	return apiChan.resp, apiChan.respErr
+	return c.run(ctx, meta, fn, c.tclient)
 }
 
 // RetryParallel runs retry as routine. Use Wait function to wait and get the response error.
@@ -152,6 +169,7 @@ func (c *CustomRetry) RetryParallel(ctx context.Context, meta interface{}, fn Re
 	retry(ctx, meta, fn, c, c.tclient)
 }
 
+// Wait waits for the result of RetryParallel
 func (c *CustomRetry) Wait() (interface{}, error) {
 	apiChan := <-c.apiChan
 
@@ -171,6 +189,8 @@ func (c *CustomRetry) setDefaultValues() {
 	if c.RetryDelay <= 0 {
 		c.RetryDelay = defaultRetryDelay
 	}
+	c.attempt = 0
+	c.delay = 0
 	if c.Cond == nil {
 		c.Cond = defaultCond
 	}
@@ -178,7 +198,7 @@ func (c *CustomRetry) setDefaultValues() {
 		c.tclient = &tokenStruct{}
 	}
 
-	c.apiChan = make(chan continueStruct)
+	c.apiChan = make(chan continueStruct, 1)
 }
 
 // Retry supports extra arguments. initialDelay will put a delay before invoking the function.
@@ -192,39 +212,21 @@ func (c *CustomRetry) Retry(
 ) (interface{}, error) {
 	c.setDefaultValues()
 
-	retry(ctx, meta, fn, c, c.tclient)
-	cChan := <-c.apiChan
-
-	return cChan.resp, cChan.respErr
+	return c.run(ctx, meta, fn, c.tclient)
 }
 
-// retryRoutineFunc implements logic for retry, this will check custom condition and call given function
-func retryRoutineFunc(
-	ctx context.Context,
-	meta interface{},
-	sChan retryChan,
-	tClient scmTokenInterface,
-	cRetry *CustomRetry,
-	fn RetryFunc,
-) {
-	tClient.setScmClientToken(&ctx, meta)
-	resp, respErr := fn(ctx)
-	c, err := cRetry.Cond(resp, respErr)
-	if err != nil {
-		sChan.errChan <- err
-
-		return
-	}
-	if c {
-		sChan.respChan <- resp
-
-		return
+// nextDelay returns the delay before the next retry. Delay requested by the
+// server with Retry-After will be honored if it is more than the backoff delay.
+func (c *CustomRetry) nextDelay(respErr error) time.Duration {
+	c.attempt++
+	if c.Backoff != nil {
+		c.delay = c.Backoff(c.attempt, c.delay)
+	} else {
+		c.delay = c.RetryDelay
 	}
-	log.Printf("[WARN] on API execution. Error:%#v, Response: %#v", err, resp)
-	time.Sleep(cRetry.RetryDelay)
-	// continue retry
-	sChan.continueChan <- continueStruct{
-		resp:    resp,
-		respErr: respErr,
+	if retryAfter := GetRetryAfter(respErr); retryAfter > c.delay {
+		c.delay = retryAfter
 	}
+
+	return c.delay
 }
@@ -0,0 +1,178 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package utils
+
+import (
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	pkgUtils "github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/utils"
+)
+
+// ErrorClass classifies the error returned from an API
+type ErrorClass int
+
+const (
+	// ErrorUnknown errors may be retried for limited number of times
+	ErrorUnknown ErrorClass = iota
+	// ErrorTransient errors are expected to resolve by itself, so should be retried
+	ErrorTransient
+	// ErrorFatal errors will not resolve on retry
+	ErrorFatal
+)
+
+// RetryAfterError wraps an API error along with the delay requested by the server
+// with Retry-After header
+type RetryAfterError struct {
+	Err        error
+	RetryAfter time.Duration
+}
+
+func (e *RetryAfterError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *RetryAfterError) Unwrap() error {
+	return e.Err
+}
+
+// WithRetryAfter wraps err with the delay parsed from Retry-After header value.
+// Header can either be delay in seconds or an HTTP date. err is returned as it is,
+// if the header is empty or invalid.
+func WithRetryAfter(err error, header string) error {
+	if err == nil {
+		return err
+	}
+	delay := parseRetryAfter(header)
+	if delay <= 0 {
+		return err
+	}
+
+	return &RetryAfterError{
+		Err:        err,
+		RetryAfter: delay,
+	}
+}
+
+// parseRetryAfter returns the delay from Retry-After header value, 0 if the header
+// is empty or invalid
+func parseRetryAfter(header string) time.Duration {
+	header = strings.TrimSpace(header)
+	if header == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(header); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(header); err == nil {
+		return time.Until(date)
+	}
+
+	return 0
+}
+
+// GetRetryAfter returns the delay requested by the server, if any
+func GetRetryAfter(err error) time.Duration {
+	var retryErr *RetryAfterError
+	if errors.As(err, &retryErr) {
+		return retryErr.RetryAfter
+	}
+
+	return 0
+}
+
+// ClassifyError classifies err based on the status code of CustomError. Throttling,
+// gateway errors and connection resets are transient, other client errors are fatal.
+func ClassifyError(err error) ErrorClass {
+	if err == nil {
+		return ErrorUnknown
+	}
+
+	switch statusCode := pkgUtils.GetStatusCode(err); {
+	case statusCode == http.StatusTooManyRequests,
+		statusCode == http.StatusBadGateway,
+		statusCode == http.StatusServiceUnavailable,
+		statusCode == http.StatusGatewayTimeout:
+		return ErrorTransient
+	case statusCode >= http.StatusBadRequest && statusCode < http.StatusInternalServerError:
+		return ErrorFatal
+	case statusCode != 0:
+		return ErrorUnknown
+	}
+
+	var netErr net.Error
+	if errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) ||
+		(errors.As(err, &netErr) && netErr.Timeout()) ||
+		strings.Contains(err.Error(), "connection reset by peer") {
+		return ErrorTransient
+	}
+
+	return ErrorUnknown
+}
+
+// ErrorCond returns CondFunc which classifies the error before invoking cond. Transient
+// errors are always retried, fatal errors terminates the retry immediately and unknown
+// errors terminates the retry if returned maxErrCount times consecutively. cond is
+// invoked only if there is no error.
+func ErrorCond(maxErrCount int, cond func(response interface{}) (bool, error)) CondFunc {
+	return errorCond(maxErrCount, cond, ClassifyError)
+}
+
+// PollErrorCond is same as ErrorCond, but should be used while polling an object until
+// it reaches a state. Not found errors are not fatal while polling, since the object
+// may not be visible in the API right after the creation. Those are retried as unknown
+// errors.
+func PollErrorCond(maxErrCount int, cond func(response interface{}) (bool, error)) CondFunc {
+	return errorCond(maxErrCount, cond, classifyPollError)
+}
+
+func classifyPollError(err error) ErrorClass {
+	if pkgUtils.GetStatusCode(err) == http.StatusNotFound {
+		return ErrorUnknown
+	}
+
+	return ClassifyError(err)
+}
+
+func errorCond(
+	maxErrCount int,
+	cond func(response interface{}) (bool, error),
+	classify func(err error) ErrorClass,
+) CondFunc {
+	errCount := 0
+
+	return func(response interface{}, responseErr error) (bool, error) {
+		if responseErr == nil {
+			errCount = 0
+
+			return cond(response)
+		}
+
+		switch classify(responseErr) {
+		case ErrorTransient:
+			return false, nil
+		case ErrorFatal:
+			return false, responseErr
+		case ErrorUnknown:
+		}
+
+		errCount++
+		if errCount >= maxErrCount {
+			return false, responseErr
+		}
+
+		return false, nil
+	}
+}
+
+// AnyResponse can be used with ErrorCond, if the response needs no validation
+func AnyResponse(response interface{}) (bool, error) {
+	return true, nil
+}
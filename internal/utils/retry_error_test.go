@@ -0,0 +1,275 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"syscall"
+	"testing"
+	"time"
+
+	api_client "github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
+)
+
+func TestClassifyError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want ErrorClass
+	}{
+		{
+			name: "Test case 1: nil error",
+			err:  nil,
+			want: ErrorUnknown,
+		},
+		{
+			name: "Test case 2: too many requests",
+			err:  api_client.CustomError{StatusCode: http.StatusTooManyRequests},
+			want: ErrorTransient,
+		},
+		{
+			name: "Test case 3: service unavailable",
+			err:  api_client.CustomError{StatusCode: http.StatusServiceUnavailable},
+			want: ErrorTransient,
+		},
+		{
+			name: "Test case 4: bad request",
+			err:  api_client.CustomError{StatusCode: http.StatusBadRequest},
+			want: ErrorFatal,
+		},
+		{
+			name: "Test case 5: internal server error",
+			err:  api_client.CustomError{StatusCode: http.StatusInternalServerError},
+			want: ErrorUnknown,
+		},
+		{
+			name: "Test case 6: connection reset",
+			err:  fmt.Errorf("get instance: %w", syscall.ECONNRESET),
+			want: ErrorTransient,
+		},
+		{
+			name: "Test case 7: retry after error",
+			err:  WithRetryAfter(api_client.CustomError{StatusCode: http.StatusTooManyRequests}, "10"),
+			want: ErrorTransient,
+		},
+		{
+			name: "Test case 8: unknown error",
+			err:  errors.New("error"),
+			want: ErrorUnknown,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ClassifyError(tt.err); got != tt.want {
+				t.Errorf("ClassifyError() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetRetryAfter(t *testing.T) {
+	apiErr := api_client.CustomError{StatusCode: http.StatusTooManyRequests}
+	tests := []struct {
+		name   string
+		header string
+		want   time.Duration
+	}{
+		{
+			name:   "Test case 1: delay in seconds",
+			header: "30",
+			want:   time.Second * 30,
+		},
+		{
+			name:   "Test case 2: empty header",
+			header: "",
+			want:   0,
+		},
+		{
+			name:   "Test case 3: invalid header",
+			header: "invalid",
+			want:   0,
+		},
+		{
+			name:   "Test case 4: date in the past",
+			header: "Wed, 21 Oct 2015 07:28:00 GMT",
+			want:   0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GetRetryAfter(WithRetryAfter(apiErr, tt.header)); got != tt.want {
+				t.Errorf("GetRetryAfter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestErrorCond(t *testing.T) {
+	unknownErr := errors.New("error")
+	fatalErr := api_client.CustomError{StatusCode: http.StatusBadRequest}
+	transientErr := api_client.CustomError{StatusCode: http.StatusBadGateway}
+
+	tests := []struct {
+		name    string
+		errs    []error
+		want    bool
+		wantErr bool
+	}{
+		{
+			name: "Normal test case 1: no error",
+			errs: []error{nil},
+			want: true,
+		},
+		{
+			name: "Normal test case 2: transient errors are retried",
+			errs: []error{transientErr, transientErr, transientErr, transientErr},
+		},
+		{
+			name: "Normal test case 3: error count reset on success",
+			errs: []error{unknownErr, unknownErr, nil, unknownErr},
+		},
+		{
+			name:    "Failed test case 1: fatal error",
+			errs:    []error{fatalErr},
+			wantErr: true,
+		},
+		{
+			name:    "Failed test case 2: max error count reached",
+			errs:    []error{unknownErr, unknownErr, unknownErr},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			cond := ErrorCond(3, func(response interface{}) (bool, error) {
+				calls++
+
+				return calls == len(tt.errs), nil
+			})
+			var got bool
+			var err error
+			for _, respErr := range tt.errs {
+				got, err = cond(nil, respErr)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ErrorCond() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ErrorCond() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPollErrorCond(t *testing.T) {
+	notFoundErr := api_client.CustomError{StatusCode: http.StatusNotFound}
+	fatalErr := api_client.CustomError{StatusCode: http.StatusBadRequest}
+
+	tests := []struct {
+		name    string
+		errs    []error
+		wantErr bool
+	}{
+		{
+			name: "Normal test case 1: not found error is retried",
+			errs: []error{notFoundErr, notFoundErr},
+		},
+		{
+			name:    "Failed test case 1: max not found error count reached",
+			errs:    []error{notFoundErr, notFoundErr, notFoundErr},
+			wantErr: true,
+		},
+		{
+			name:    "Failed test case 2: fatal error",
+			errs:    []error{fatalErr},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cond := PollErrorCond(3, AnyResponse)
+			var err error
+			for _, respErr := range tt.errs {
+				_, err = cond(nil, respErr)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PollErrorCond() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		name    string
+		backoff BackoffFunc
+		want    []time.Duration
+	}{
+		{
+			name:    "Test case 1: constant backoff",
+			backoff: ConstantBackoff(time.Second),
+			want:    []time.Duration{time.Second, time.Second, time.Second},
+		},
+		{
+			name:    "Test case 2: exponential backoff",
+			backoff: ExponentialBackoff(time.Second, time.Second*5),
+			want:    []time.Duration{time.Second, time.Second * 2, time.Second * 4, time.Second * 5},
+		},
+		{
+			name:    "Test case 3: exponential backoff without cap",
+			backoff: ExponentialBackoff(time.Second, 0),
+			want:    []time.Duration{time.Second, time.Second * 2, time.Second * 4, time.Second * 8},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var prev time.Duration
+			for i, want := range tt.want {
+				prev = tt.backoff(i+1, prev)
+				if prev != want {
+					t.Errorf("attempt %d: backoff = %v, want %v", i+1, prev, want)
+				}
+			}
+		})
+	}
+}
+
+func TestDecorrelatedJitterBackoff(t *testing.T) {
+	base := time.Second
+	maxDelay := time.Second * 10
+	backoff := DecorrelatedJitterBackoff(base, maxDelay)
+
+	var prev time.Duration
+	for i := 1; i <= 20; i++ {
+		delay := backoff(i, prev)
+		if delay < base || delay > maxDelay {
+			t.Fatalf("attempt %d: backoff = %v, want in between %v and %v", i, delay, base, maxDelay)
+		}
+		if prev >= base && delay > prev*3 {
+			t.Fatalf("attempt %d: backoff = %v, want less than %v", i, delay, prev*3)
+		}
+		prev = delay
+	}
+}
+
+func TestCustomRetry_nextDelay(t *testing.T) {
+	c := CustomRetry{
+		RetryDelay: time.Second,
+		Backoff:    ExponentialBackoff(time.Second, time.Minute),
+	}
+	if got := c.nextDelay(nil); got != time.Second {
+		t.Errorf("nextDelay() = %v, want %v", got, time.Second)
+	}
+	if got := c.nextDelay(errors.New("error")); got != time.Second*2 {
+		t.Errorf("nextDelay() = %v, want %v", got, time.Second*2)
+	}
+	retryAfterErr := WithRetryAfter(api_client.CustomError{StatusCode: http.StatusTooManyRequests}, "30")
+	if got := c.nextDelay(retryAfterErr); got != time.Second*30 {
+		t.Errorf("nextDelay() = %v, want %v", got, time.Second*30)
+	}
+}
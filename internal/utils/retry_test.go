@@ -8,6 +8,7 @@ import (
 	"context"
 	"errors"
 	"reflect"
+	"runtime"
 	"testing"
 	"time"
 
@@ -243,3 +244,131 @@ func TestCustomRetry_Wait(t *testing.T) {
 		})
 	}
 }
+
+// checkGoroutineLeak fails the test if number of goroutines does not drop back to
+// want within a second
+func checkGoroutineLeak(t *testing.T, want int) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for runtime.NumGoroutine() > want {
+		if time.Now().After(deadline) {
+			t.Errorf("goroutine leak, got %d goroutines, want %d", runtime.NumGoroutine(), want)
+
+			return
+		}
+		time.Sleep(time.Millisecond * 10)
+	}
+}
+
+func TestCustomRetry_leak(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	meta := "mock meta"
+	tests := []struct {
+		name     string
+		cRetry   CustomRetry
+		fn       RetryFunc
+		cancel   time.Duration
+		parallel bool
+		wantErr  string
+	}{
+		{
+			name: "Failed test case 1 - context cancelled while executing",
+			cRetry: CustomRetry{
+				RetryDelay: time.Hour,
+			},
+			fn: func(ctx context.Context) (interface{}, error) {
+				<-ctx.Done()
+
+				return nil, ctx.Err()
+			},
+			cancel:  time.Millisecond * 10,
+			wantErr: "context timed out",
+		},
+		{
+			name: "Failed test case 2 - context cancelled while waiting",
+			cRetry: CustomRetry{
+				RetryDelay: time.Hour,
+				Timeout:    time.Hour,
+			},
+			fn: func(ctx context.Context) (interface{}, error) {
+				return nil, errors.New("error")
+			},
+			cancel:  time.Millisecond * 10,
+			wantErr: "context timed out",
+		},
+		{
+			name: "Failed test case 3 - retry timed out while waiting",
+			cRetry: CustomRetry{
+				InitialDelay: time.Hour,
+				Timeout:      time.Millisecond * 10,
+			},
+			fn: func(ctx context.Context) (interface{}, error) {
+				return testRetrySuccess, nil
+			},
+			wantErr: "retry timed out",
+		},
+		{
+			name: "Failed test case 4 - retry timed out while executing",
+			cRetry: CustomRetry{
+				Timeout: time.Millisecond * 10,
+			},
+			fn: func(ctx context.Context) (interface{}, error) {
+				<-ctx.Done()
+
+				return nil, ctx.Err()
+			},
+			wantErr: "retry timed out",
+		},
+		{
+			name: "Failed test case 5 - retry count exceeds",
+			cRetry: CustomRetry{
+				RetryCount: 3,
+				RetryDelay: time.Millisecond,
+			},
+			fn: func(ctx context.Context) (interface{}, error) {
+				return nil, errors.New("error")
+			},
+			wantErr: "maximum retry limit reached, with Error: error, Response: <nil>",
+		},
+		{
+			name: "Normal test case 1 - result of parallel retry is not read",
+			cRetry: CustomRetry{
+				RetryDelay: time.Millisecond,
+			},
+			fn: func(ctx context.Context) (interface{}, error) {
+				return testRetrySuccess, nil
+			},
+			parallel: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewMockscmTokenInterface(ctrl)
+			m.EXPECT().setScmClientToken(gomock.Any(), meta).AnyTimes()
+			tt.cRetry.tclient = m
+
+			goroutines := runtime.NumGoroutine()
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if tt.cancel != 0 {
+				time.AfterFunc(tt.cancel, cancel)
+			}
+
+			if tt.parallel {
+				tt.cRetry.RetryParallel(ctx, meta, tt.fn)
+				checkGoroutineLeak(t, goroutines)
+
+				return
+			}
+
+			_, err := tt.cRetry.Retry(ctx, meta, tt.fn)
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("CustomRetry.Retry() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			cancel()
+			checkGoroutineLeak(t, goroutines)
+		})
+	}
+}
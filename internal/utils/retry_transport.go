@@ -0,0 +1,79 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package utils
+
+import (
+	"io"
+	"net/http"
+	"time"
+)
+
+const (
+	retryAfterMaxRetries = 3
+	retryAfterMaxDelay   = time.Minute
+)
+
+// RetryAfterTransport resends the request if the server throttles it with Retry-After
+// header. SDK API calls does not expose the response headers on error, hence the delay
+// requested by the server is honored here for all the API calls.
+type RetryAfterTransport struct {
+	// Base is used to send the request. http.DefaultTransport is used if not set
+	Base http.RoundTripper
+	// MaxRetries is the maximum number of times a throttled request is resent
+	MaxRetries int
+	// MaxDelay is the maximum delay honored. If server requests for more delay,
+	// response is returned as it is, so that the caller can decide on it
+	MaxDelay time.Duration
+}
+
+// NewRetryAfterTransport returns RetryAfterTransport with default retry count and
+// maximum delay
+func NewRetryAfterTransport(base http.RoundTripper) *RetryAfterTransport {
+	return &RetryAfterTransport{
+		Base:       base,
+		MaxRetries: retryAfterMaxRetries,
+		MaxDelay:   retryAfterMaxDelay,
+	}
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *RetryAfterTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	for attempt := 0; ; attempt++ {
+		resp, err := base.RoundTrip(req)
+		if err != nil || attempt >= t.MaxRetries || !isThrottled(resp.StatusCode) {
+			return resp, err
+		}
+
+		delay := parseRetryAfter(resp.Header.Get("Retry-After"))
+		if delay <= 0 || delay > t.MaxDelay {
+			return resp, nil
+		}
+		// request can be resent only if the body can be read again
+		if req.Body != nil && req.Body != http.NoBody {
+			if req.GetBody == nil {
+				return resp, nil
+			}
+			body, err := req.GetBody()
+			if err != nil {
+				return resp, nil
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+		if !sleepWithContext(req.Context(), delay) {
+			return nil, req.Context().Err()
+		}
+	}
+}
+
+func isThrottled(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode == http.StatusServiceUnavailable
+}
@@ -0,0 +1,101 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package utils
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRetryAfterTransport_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name       string
+		retryAfter string
+		throttled  int
+		maxRetries int
+		wantStatus int
+		wantCalls  int
+	}{
+		{
+			name:       "Normal test case 1: no throttling",
+			maxRetries: 3,
+			wantStatus: http.StatusOK,
+			wantCalls:  1,
+		},
+		{
+			name:       "Normal test case 2: throttled request is resent after the delay",
+			retryAfter: "1",
+			throttled:  1,
+			maxRetries: 3,
+			wantStatus: http.StatusOK,
+			wantCalls:  2,
+		},
+		{
+			name:       "Normal test case 3: delay more than maximum delay is not honored",
+			retryAfter: "3600",
+			throttled:  1,
+			maxRetries: 3,
+			wantStatus: http.StatusTooManyRequests,
+			wantCalls:  1,
+		},
+		{
+			name:       "Normal test case 4: throttled response without Retry-After",
+			throttled:  1,
+			maxRetries: 3,
+			wantStatus: http.StatusTooManyRequests,
+			wantCalls:  1,
+		},
+		{
+			name:       "Failed test case 1: maximum retries reached",
+			retryAfter: "1",
+			throttled:  2,
+			maxRetries: 1,
+			wantStatus: http.StatusTooManyRequests,
+			wantCalls:  2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				body, _ := io.ReadAll(r.Body)
+				if string(body) != "request" {
+					t.Errorf("request body = %q, want %q", body, "request")
+				}
+				if calls <= tt.throttled {
+					if tt.retryAfter != "" {
+						w.Header().Set("Retry-After", tt.retryAfter)
+					}
+					w.WriteHeader(http.StatusTooManyRequests)
+
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			client := &http.Client{
+				Transport: &RetryAfterTransport{
+					MaxRetries: tt.maxRetries,
+					MaxDelay:   time.Minute,
+				},
+			}
+			resp, err := client.Post(server.URL, "text/plain", strings.NewReader("request"))
+			if err != nil {
+				t.Fatalf("RoundTrip() error = %v", err)
+			}
+			resp.Body.Close()
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("RoundTrip() status = %d, want %d", resp.StatusCode, tt.wantStatus)
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("RoundTrip() calls = %d, want %d", calls, tt.wantCalls)
+			}
+		})
+	}
+}
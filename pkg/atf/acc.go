@@ -23,6 +23,12 @@ type Acc struct {
 	GetAPI       GetAPIFunc
 	ResourceName string
 	Version      string
+	// Import adds a step to import the resource after the test steps and verifies
+	// the imported state with the state of the last step
+	Import bool
+	// ImportStateVerifyIgnore is the list of attributes, which are not populated
+	// on import
+	ImportStateVerifyIgnore []string
 }
 
 // RunResourcePlanTest to run resource plan only test case. This will take first
@@ -37,9 +43,10 @@ func (a *Acc) RunResourcePlanTest(t *testing.T) {
 func (a *Acc) RunDataSourceTests(t *testing.T) {
 	r := newReader(t, false, a.ResourceName)
 	checkSkip(t)
+	r.useCassette(a.Version, false)
 	testSteps := r.getTestCases(a.Version, a.GetAPI)
 
-	resource.ParallelTest(t, resource.TestCase{
+	runTest(t, resource.TestCase{
 		IsUnitTest: false,
 		PreCheck:   func() { a.PreCheck(t) },
 		Providers:  a.Providers,
@@ -52,9 +59,18 @@ func (a *Acc) RunResourceTests(t *testing.T) {
 	checkSkip(t)
 	// populate test cases
 	r := newReader(t, true, a.ResourceName)
+	r.useCassette(a.Version, false)
 	testSteps := r.getTestCases(a.Version, a.GetAPI)
+	if a.Import {
+		testSteps = append(testSteps, resource.TestStep{
+			ResourceName:            a.resourceAddress(),
+			ImportState:             true,
+			ImportStateVerify:       true,
+			ImportStateVerifyIgnore: a.ImportStateVerifyIgnore,
+		})
+	}
 
-	resource.ParallelTest(t, resource.TestCase{
+	runTest(t, resource.TestCase{
 		PreCheck:  func() { a.PreCheck(t) },
 		Providers: a.Providers,
 		CheckDestroy: resource.ComposeTestCheckFunc(
@@ -67,7 +83,7 @@ func (a *Acc) RunResourceTests(t *testing.T) {
 // checkResourceDestroy checks resource destroy conditions. This function will parse error
 // and check status code is 404 or not
 func (a *Acc) checkResourceDestroy(s *terraform.State) error {
-	rs, ok := s.RootModule().Resources[fmt.Sprintf("%s.tf_%s", a.ResourceName, getLocalName(a.ResourceName))]
+	rs, ok := s.RootModule().Resources[a.resourceAddress()]
 	if !ok {
 		return fmt.Errorf("[Check Destroy] resource %s not found", a.ResourceName)
 	}
@@ -80,12 +96,18 @@ func (a *Acc) checkResourceDestroy(s *terraform.State) error {
 	return nil
 }
 
+// resourceAddress returns the address of the resource in the test config
+func (a *Acc) resourceAddress() string {
+	return fmt.Sprintf("%s.tf_%s", a.ResourceName, getLocalName(a.ResourceName))
+}
+
 // runs plan test for resource or data source. only first config from test case
 // will considered on plan test
 func (a *Acc) runPlanTest(t *testing.T, isResource bool) {
 	r := newReader(t, isResource, a.ResourceName)
+	r.useCassette(a.Version, true)
 	testSteps := r.getTestCases(a.Version, a.GetAPI)
-	resource.ParallelTest(t, resource.TestCase{
+	runTest(t, resource.TestCase{
 		PreCheck:  func() { a.PreCheck(t) },
 		Providers: a.Providers,
 		Steps: []resource.TestStep{
@@ -99,6 +121,18 @@ func (a *Acc) runPlanTest(t *testing.T, isResource bool) {
 	})
 }
 
+// runTest runs test case in parallel. On record or replay mode, test cases are
+// run one after another since API calls are recorded to the cassette of the
+// running test case
+func runTest(t *testing.T, c resource.TestCase) {
+	if getRecordMode() != "" {
+		resource.Test(t, c)
+
+		return
+	}
+	resource.ParallelTest(t, c)
+}
+
 func checkSkip(t *testing.T) {
 	if strings.ToLower(os.Getenv("TF_ACC")) != "true" && os.Getenv("TF_ACC") != "1" {
 		t.Skip("acceptance test is skipped since TF_ACC is not set")
@@ -0,0 +1,286 @@
+package atf
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/constants"
+)
+
+const (
+	recordMode = "record"
+	replayMode = "replay"
+
+	cassetteExt = ".cassette.json"
+)
+
+// query params which are specific to the environment. These are neither recorded
+// nor matched, so that cassettes can be replayed with any location and space
+var ignoredQueryParams = map[string]bool{
+	constants.LocationKey: true,
+	constants.SpaceKey:    true,
+}
+
+// response headers which are recorded. Other headers are skipped, since those may
+// contain environment specific details
+var recordedHeaders = []string{"Content-Type", "Retry-After"}
+
+type recordedRequest struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body   string `json:"body,omitempty"`
+}
+
+type recordedResponse struct {
+	StatusCode int               `json:"status_code"`
+	Header     map[string]string `json:"header,omitempty"`
+	Body       string            `json:"body"`
+}
+
+type interaction struct {
+	Request  recordedRequest  `json:"request"`
+	Response recordedResponse `json:"response"`
+}
+
+// cassette holds API calls of a test case. Vars are recorded along with the API
+// calls, so that random values in the test case are same on replay
+type cassette struct {
+	Vars         map[string]interface{} `json:"vars,omitempty"`
+	Interactions []interaction          `json:"interactions"`
+
+	path string
+	mode string
+	used []bool
+}
+
+// recorder records API calls to the active cassette on record mode and serves
+// API calls from the active cassette on replay mode
+type recorder struct {
+	mu        sync.Mutex
+	cassette  *cassette
+	transport http.RoundTripper
+}
+
+var defaultRecorder = &recorder{transport: http.DefaultTransport}
+
+// HTTPClient returns http client to be used by CMP API client on acceptance test.
+// Returns nil if record or replay mode is not set, so that default http client
+// will be used
+func HTTPClient() *http.Client {
+	if getRecordMode() == "" {
+		return nil
+	}
+
+	return &http.Client{Transport: defaultRecorder}
+}
+
+func getRecordMode() string {
+	switch mode := strings.ToLower(os.Getenv(constants.AccRecordModeKey)); mode {
+	case recordMode, replayMode:
+		return mode
+	default:
+		return ""
+	}
+}
+
+// startCassette loads cassette on path and sets it as active cassette till the end
+// of test. On record mode cassette will be saved once the test is passed. Returns nil
+// if record or replay mode is not set.
+func startCassette(t *testing.T, path string) *cassette {
+	mode := getRecordMode()
+	if mode == "" {
+		return nil
+	}
+
+	c := &cassette{
+		Vars: make(map[string]interface{}),
+		path: path,
+		mode: mode,
+	}
+	if mode == replayMode {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Skipf("[acc-test] test case is skipped on replay mode. error while reading cassette, %v", err)
+		}
+		if err := json.Unmarshal(data, c); err != nil {
+			t.Fatalf("[acc-test] error while parsing cassette %s, %v", path, err)
+		}
+		c.used = make([]bool, len(c.Interactions))
+	}
+
+	defaultRecorder.setCassette(c)
+	t.Cleanup(func() {
+		defaultRecorder.setCassette(nil)
+		if mode != recordMode {
+			return
+		}
+		if t.Failed() {
+			t.Logf("[acc-test] cassette %s is not saved since test is failed", path)
+
+			return
+		}
+		if err := c.save(); err != nil {
+			t.Errorf("[acc-test] error while saving cassette %s, %v", path, err)
+		}
+	})
+
+	return c
+}
+
+func (c *cassette) save() error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(c.path, append(data, '\n'), 0o644) //nolint:gosec
+}
+
+// find returns response of the first unused interaction which matches request.
+// If all matching interactions are used, last matching interaction is returned,
+// so that additional polling on replay gets the final state
+func (c *cassette) find(req recordedRequest) (recordedResponse, bool) {
+	last := -1
+	for i, in := range c.Interactions {
+		if in.Request.Method != req.Method || in.Request.URL != req.URL {
+			continue
+		}
+		if !c.used[i] {
+			c.used[i] = true
+
+			return in.Response, true
+		}
+		last = i
+	}
+	if last == -1 {
+		return recordedResponse{}, false
+	}
+
+	return c.Interactions[last].Response, true
+}
+
+func (r *recorder) setCassette(c *cassette) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cassette = c
+}
+
+func (r *recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	r.mu.Lock()
+	c := r.cassette
+	r.mu.Unlock()
+	if c == nil {
+		return r.transport.RoundTrip(req)
+	}
+
+	recReq, err := newRecordedRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if c.mode == replayMode {
+		r.mu.Lock()
+		recResp, ok := c.find(recReq)
+		r.mu.Unlock()
+		if !ok {
+			return nil, fmt.Errorf("[acc-test] no interaction found in cassette %s for %s %s",
+				c.path, recReq.Method, recReq.URL)
+		}
+
+		return recResp.toResponse(req), nil
+	}
+
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	recResp := recordedResponse{
+		StatusCode: resp.StatusCode,
+		Header:     make(map[string]string),
+		Body:       string(body),
+	}
+	for _, h := range recordedHeaders {
+		if v := resp.Header.Get(h); v != "" {
+			recResp.Header[h] = v
+		}
+	}
+	r.mu.Lock()
+	c.Interactions = append(c.Interactions, interaction{Request: recReq, Response: recResp})
+	r.mu.Unlock()
+
+	return resp, nil
+}
+
+// newRecordedRequest populates recordedRequest from the request. Host and
+// environment specific query params are skipped, since cassette can be replayed
+// against any host
+func newRecordedRequest(req *http.Request) (recordedRequest, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return recordedRequest{}, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	query := req.URL.Query()
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		if !ignoredQueryParams[key] {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	params := make([]string, 0, len(keys))
+	for _, key := range keys {
+		for _, v := range query[key] {
+			params = append(params, key+"="+v)
+		}
+	}
+	url := req.URL.Path
+	if len(params) > 0 {
+		url += "?" + strings.Join(params, "&")
+	}
+
+	return recordedRequest{
+		Method: req.Method,
+		URL:    url,
+		Body:   string(body),
+	}, nil
+}
+
+func (r recordedResponse) toResponse(req *http.Request) *http.Response {
+	header := make(http.Header)
+	for key, v := range r.Header {
+		header.Set(key, v)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", r.StatusCode, http.StatusText(r.StatusCode)),
+		StatusCode:    r.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(r.Body)),
+		ContentLength: int64(len(r.Body)),
+		Request:       req,
+	}
+}
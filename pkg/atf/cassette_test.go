@@ -0,0 +1,96 @@
+package atf
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/constants"
+)
+
+func getBody(t *testing.T, url string) (int, string) {
+	resp, err := HTTPClient().Get(url)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("ReadAll() error = %v", err)
+	}
+
+	return resp.StatusCode, string(body)
+}
+
+func Test_cassette(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.URL.Path == "/v1/instances/1" {
+			fmt.Fprintf(w, `{"status": "call %d"}`, calls)
+
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+	path := filepath.Join(t.TempDir(), "instance"+cassetteExt)
+
+	t.Run("record", func(t *testing.T) {
+		t.Setenv(constants.AccRecordModeKey, recordMode)
+		startCassette(t, path)
+		getBody(t, server.URL+"/v1/instances/1?location=l1&space=s1")
+		getBody(t, server.URL+"/v1/instances/1?location=l1&space=s1")
+		getBody(t, server.URL+"/v1/instances/2")
+	})
+
+	tests := []struct {
+		name       string
+		url        string
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "Normal test case 1: first call",
+			url:        "http://replay/v1/instances/1?location=l2&space=s2",
+			wantStatus: http.StatusOK,
+			wantBody:   `{"status": "call 1"}`,
+		},
+		{
+			name:       "Normal test case 2: second call",
+			url:        "http://replay/v1/instances/1",
+			wantStatus: http.StatusOK,
+			wantBody:   `{"status": "call 2"}`,
+		},
+		{
+			name:       "Normal test case 3: calls more than recorded returns last",
+			url:        "http://replay/v1/instances/1",
+			wantStatus: http.StatusOK,
+			wantBody:   `{"status": "call 2"}`,
+		},
+		{
+			name:       "Normal test case 4: error response",
+			url:        "http://replay/v1/instances/2",
+			wantStatus: http.StatusNotFound,
+		},
+	}
+	t.Run("replay", func(t *testing.T) {
+		t.Setenv(constants.AccRecordModeKey, replayMode)
+		startCassette(t, path)
+		for _, tt := range tests {
+			statusCode, body := getBody(t, tt.url)
+			if statusCode != tt.wantStatus || body != tt.wantBody {
+				t.Errorf("%s: got %d %s, want %d %s", tt.name, statusCode, body, tt.wantStatus, tt.wantBody)
+			}
+		}
+		if _, err := HTTPClient().Get("http://replay/v1/instances/3"); err == nil {
+			t.Errorf("Get() on request which is not recorded, want error")
+		}
+	})
+	if calls != 3 {
+		t.Errorf("server got %d calls, want 3", calls)
+	}
+}
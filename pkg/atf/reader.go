@@ -33,6 +33,7 @@ type reader struct {
 	name        string
 	expectError *regexp.Regexp
 	vars        map[string]interface{}
+	cassette    *cassette
 }
 
 func newReader(t *testing.T, isResource bool, name string) *reader {
@@ -61,9 +62,23 @@ func (r *reader) readVars(v *viper.Viper) {
 	for key, val := range vars {
 		r.vars[key] = parseMeta(fmt.Sprint(val))
 	}
+
+	// on replay, vars are taken from cassette so that API calls are same as recorded
+	if r.cassette == nil {
+		return
+	}
+	if r.cassette.mode == replayMode {
+		for key, val := range r.cassette.Vars {
+			r.vars[key] = val
+		}
+
+		return
+	}
+	r.cassette.Vars = r.vars
 }
 
-func (r *reader) getViperConfig(version string) *viper.Viper {
+// configPath returns path of the test case configuration without extension
+func (r *reader) configPath(version string) string {
 	tfName := getLocalName(r.name)
 	if path := os.Getenv(constants.AccTestPathKey); path != "" {
 		accTestPath = path
@@ -72,8 +87,23 @@ func (r *reader) getViperConfig(version string) *viper.Viper {
 	if version != "" {
 		postfix = fmt.Sprintf("-%s", version)
 	}
+
+	return fmt.Sprintf("%s/%s/%s%s", accTestPath, getTag(r.isResource), tfName, postfix)
+}
+
+// useCassette sets cassette for the test case, which is placed along with the test
+// case configuration. Plan tests uses separate cassette with plan suffix
+func (r *reader) useCassette(version string, isPlan bool) {
+	path := r.configPath(version)
+	if isPlan {
+		path += ".plan"
+	}
+	r.cassette = startCassette(r.t, path+cassetteExt)
+}
+
+func (r *reader) getViperConfig(version string) *viper.Viper {
 	v := viper.New()
-	v.SetConfigFile(fmt.Sprintf("%s/%s/%s%s.yaml", accTestPath, getTag(r.isResource), tfName, postfix))
+	v.SetConfigFile(r.configPath(version) + ".yaml")
 	err := v.ReadInConfig()
 	if err != nil {
 		r.skipf("error while reading config, %v", err)
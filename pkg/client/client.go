@@ -4,10 +4,12 @@ package client
 
 import (
 	"fmt"
+	"net/http"
 	"os"
 
 	api_client "github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
 	cmp_client "github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/cmp"
+	cmpUtils "github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/constants"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -39,7 +41,10 @@ func getHeaders() map[string]string {
 }
 
 // InitialiseClient is imported by hpegl from each service repo
-type InitialiseClient struct{}
+type InitialiseClient struct {
+	// HTTPClient is used for CMP API calls. Default http client is used if not set
+	HTTPClient *http.Client
+}
 
 // NewClient takes an argument of all of the provider.ConfigData, and returns an interface{} and error
 // If there is no error interface{} will contain *Client.
@@ -54,6 +59,13 @@ func (i InitialiseClient) NewClient(r *schema.ResourceData) (interface{}, error)
 	// Create VMaas Client
 	client := new(Client)
 
+	// resend the throttled requests after the delay requested by the server
+	httpClient := http.Client{}
+	if i.HTTPClient != nil {
+		httpClient = *i.HTTPClient
+	}
+	httpClient.Transport = cmpUtils.NewRetryAfterTransport(httpClient.Transport)
+
 	cfg := api_client.Configuration{
 		Host:          vmaasProviderSettings[constants.APIURL].(string),
 		DefaultHeader: getHeaders(),
@@ -61,6 +73,7 @@ func (i InitialiseClient) NewClient(r *schema.ResourceData) (interface{}, error)
 			constants.SpaceKey:    vmaasProviderSettings[constants.SPACENAME].(string),
 			constants.LocationKey: vmaasProviderSettings[constants.LOCATION].(string),
 		},
+		HTTPClient: &httpClient,
 	}
 	apiClient := api_client.NewAPIClient(&cfg)
 	utils.SetMeta(apiClient, r)
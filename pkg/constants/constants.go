@@ -16,6 +16,10 @@ const (
 	LocationKey = "location"
 
 	MockIAMKey     = "TF_ACC_MOCK_IAM"
+	MockCMPKey     = "TF_ACC_MOCK_CMP"
 	CmpSubjectKey  = "TF_ACC_CMP_SUBJECT"
 	AccTestPathKey = "TF_ACC_TEST_PATH"
+	// AccRecordModeKey sets acceptance test to record API calls to cassettes
+	// or to replay API calls from cassettes. Supported values are record and replay
+	AccRecordModeKey = "TF_ACC_RECORD_MODE"
 )
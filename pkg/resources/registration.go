@@ -1,4 +1,4 @@
-// (C) Copyright 2021-2022 Hewlett Packard Enterprise Development LP
+// (C) Copyright 2021-2024 Hewlett Packard Enterprise Development LP
 
 package resources
 
@@ -54,18 +54,34 @@ func (r Registration) SupportedResources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
 		resources.ResInstance:                   resources.Instances(),
 		resources.ResInstanceClone:              resources.InstancesClone(),
+		resources.ResInstanceSnapshot:           resources.InstanceSnapshot(),
 		resources.ResNetwork:                    resources.Network(),
+		resources.ResNetworkPool:                resources.NetworkPool(),
+		resources.ResIPAddress:                  resources.IPAddress(),
 		resources.ResRouter:                     resources.Router(),
 		resources.ResRouterNat:                  resources.RouterNatRule(),
 		resources.ResRouterFirewallRuleGroup:    resources.RouterFirewallRuleGroup(),
+		resources.ResRouterFirewallRule:         resources.RouterFirewallRule(),
 		resources.ResRouterRoute:                resources.RouterRoute(),
 		resources.ResRouterBgpNeighbor:          resources.RouterBgpNeighbor(),
+		resources.ResRouterInterface:            resources.RouterInterface(),
+		resources.ResRouterVpnService:           resources.RouterVpnService(),
+		resources.ResRouterVpnLocalEndpoint:     resources.RouterVpnLocalEndpoint(),
+		resources.ResRouterVpnIkeProfile:        resources.RouterVpnIkeProfile(),
+		resources.ResRouterVpnIpsecProfile:      resources.RouterVpnIpsecProfile(),
+		resources.ResRouterVpnDpdProfile:        resources.RouterVpnDpdProfile(),
+		resources.ResRouterVpnSession:           resources.RouterVpnSession(),
+		resources.ResSecurityGroup:              resources.SecurityGroup(),
+		resources.ResDistributedFirewallPolicy:  resources.DistributedFirewallPolicy(),
 		resources.ResLoadBalancer:               resources.LoadBalancer(),
 		resources.ResLoadBalancerMonitors:       resources.LoadBalancerMonitor(),
 		resources.ResLoadBalancerProfiles:       resources.LoadBalancerProfiles(),
 		resources.ResLoadBalancerPools:          resources.LoadBalancerPools(),
 		resources.ResLoadBalancerVirtualServers: resources.LoadBalancerVirtualServers(),
 		resources.ResDhcpServer:                 resources.DhcpServer(),
+		resources.ResDhcpStaticBinding:          resources.DhcpStaticBinding(),
+		resources.ResDhcpRelay:                  resources.DhcpRelay(),
+		resources.ResCertificate:                resources.Certificate(),
 	}
 }
 
@@ -5,6 +5,7 @@ import (
 	"log"
 
 	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/constants"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hewlettpackard/hpegl-provider-lib/pkg/token/retrieve"
 	"github.com/hewlettpackard/hpegl-provider-lib/pkg/token/serviceclient"
@@ -12,6 +13,10 @@ import (
 
 func SetMeta(apiClient *client.APIClient, r *schema.ResourceData) {
 	err := apiClient.SetMeta(nil, func(ctx *context.Context, meta interface{}) {
+		if GetEnvBool(constants.MockIAMKey) {
+			return
+		}
+
 		// Initialise token handler
 		h, err := serviceclient.NewHandler(r)
 		if err != nil {